@@ -2,8 +2,10 @@ package main
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"runtime"
 	"strings"
 	"time"
@@ -15,27 +17,88 @@ import (
 )
 
 type backupCmd struct {
-	Dirs []string `arg:"" name:"dir" help:"Directories to snapshot and prepare to backup." type:"existingdir"`
+	Dirs []string `arg:"" name:"dir" help:"Directories (or files) to snapshot and prepare to backup." type:"path"`
 
 	ProviderID string        `help:"Select which provider to use."`
 	Timeout    time.Duration `help:"Timeout to create snapshot."`
 	Simple     bool          `help:"Try to do it as simple as possible, but not simpler. In Windows this means do not use VSS Writers."`
-	Exec       string        `short:"e" help:"Command to execute after taking the snapshot. The snaphshot path(s) will be added to the end. If not set, this command waits for user input before deleting the snapshot(s)."`
+	Exec       string        `short:"e" help:"Command to execute after taking the snapshot. The snaphshot path(s) will be added to the end, unless it uses the placeholders {snap:<dir>}, {orig:<dir>} or {all}. If not set, this command waits for user input before deleting the snapshot(s)."`
 	NoShell    bool          `help:"Do not pass the exec command to the shell to execute."`
 
+	MinFreeSpace     byteSize `placeholder:"SIZE" help:"Do not create snapshots in volumes with less free space than this (like 10G)."`
+	MinFreePercent   float64  `placeholder:"PERCENT" help:"Do not create snapshots in volumes with less free space than this percentage of their size."`
+	MaxSnapshotsSize byteSize `placeholder:"SIZE" help:"Do not create snapshots in volumes where the snapshots already use more than this (like 50G). Only checked if the provider knows the space used."`
+	FreeSpaceAction  string   `enum:"refuse,delete-oldest" default:"refuse" help:"What to do if a volume exceeds the free space limits: refuse to create the snapshot, or delete the oldest snapshots of the volume (not in use and not protected) until it doesn't."`
+	Protect          []string `help:"IDs of snapshots that must not be deleted by --free-space-action delete-oldest."`
+
+	Monitor    bool     `help:"Check the snapshots while the command executes: warn when their copy-on-write space is almost full, and fail if one of them becomes invalid."`
+	AutoExtend byteSize `placeholder:"SIZE" help:"With --monitor, extend the copy-on-write space by this size (like 1G) when it is 90% used. Only supported in Windows and by LVM."`
+
+	FailurePolicy  string        `enum:"fallback,skip,abort" default:"fallback" help:"What to do if a snapshot can't be created: use the original directory, skip the directory or abort the backup."`
+	RetryAttempts  int           `placeholder:"N" help:"Try to create each snapshot up to N times."`
+	RetryBackoff   time.Duration `placeholder:"DURATION" help:"With --retry-attempts, time to wait before the second attempt. It doubles after each attempt, up to 1 minute."`
+	RetryAllErrors bool          `help:"With --retry-attempts, retry all errors, and not only the ones known to be transient."`
+
+	Report string `enum:"text,json" default:"text" help:"Format of the report of the snapshots, printed before executing the command. With json it is written to stdout, and the other messages to stderr."`
+
+	PrivateMounts bool `help:"Linux only: execute the command in a private mount namespace, where the snapshots are mounted over the original directories. The command sees the snapshot data in the original paths, and receives the original paths. Needs root."`
+
 	ServerArgs serverArgs `embed:""`
+
+	// Used by the run command
+	env      []string
+	mappings []*snapshotMapping
+}
+
+func (c *backupCmd) WritesToStdout() bool {
+	return c.Report == reportJson
 }
 
 func (c *backupCmd) Run(ctx *context) error {
-	cmd, err := c.createExecCommand()
+	for _, dir := range c.Dirs {
+		err := checkSnapshotPath(dir)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd, err := c.createExecCommand(ctx)
+	if err != nil {
+		return err
+	}
+
+	if c.PrivateMounts && cmd == nil {
+		return errors.New("--private-mounts needs a command to execute")
+	}
+
+	freeSpace, err := c.createFreeSpaceConfig()
+	if err != nil {
+		return err
+	}
+
+	monitor, err := c.createMonitorConfig()
+	if err != nil {
+		return err
+	}
+
+	retry, err := c.createRetryConfig()
+	if err != nil {
+		return err
+	}
+
+	failurePolicy, err := fs_snapshot.ParseFailurePolicy(c.FailurePolicy)
 	if err != nil {
 		return err
 	}
 
 	backuper, err := ctx.snapshoter.StartBackup(&fs_snapshot.BackupConfig{
-		ProviderID: c.ProviderID,
-		Timeout:    c.Timeout,
-		Simple:     c.Simple,
+		ProviderID:    c.ProviderID,
+		Timeout:       c.Timeout,
+		Simple:        c.Simple,
+		FreeSpace:     freeSpace,
+		Monitor:       monitor,
+		Retry:         retry,
+		FailurePolicy: failurePolicy,
 	})
 	if err != nil {
 		return err
@@ -43,61 +106,236 @@ func (c *backupCmd) Run(ctx *context) error {
 
 	defer backuper.Close()
 
-	var snapshotDirs []string
+	var mappings []*snapshotMapping
+	var abortErr error
+	mounts := make(map[string]string)
 
 	for _, dir := range c.Dirs {
-		snapshotDir, _, err := backuper.TryToCreateTemporarySnapshot(dir)
+		snapshotDir, snapshot, err := backuper.TryToCreateTemporarySnapshot(dir)
+		snapshoted := snapshot != nil && err == nil
+
 		switch {
-		case err != nil:
-			ctx.console.Printf("%v: Error creating snapshot: %v", dir, err)
-		default:
-			ctx.console.Printf("%v: Snapshot path is %v", dir, snapshotDir)
+		case errors.Is(err, fs_snapshot.ErrBackupAborted):
+			abortErr = errors.Wrapf(err, "%v: Error creating snapshot", dir)
+		case snapshot == nil && err == nil && failurePolicy == fs_snapshot.FailurePolicyAbort:
+			abortErr = errors.Errorf("%v: Snapshot not supported", dir)
+		case errors.Is(err, fs_snapshot.ErrDirectorySkipped):
+			continue
+		case snapshot == nil && err == nil && failurePolicy == fs_snapshot.FailurePolicySkip:
+			ctx.console.Printf("%v: Snapshot not supported, skipping directory", dir)
+			continue
+		}
+
+		if abortErr != nil {
+			break
+		}
+
+		absDir, err := filepath.Abs(dir)
+		if err != nil {
+			return err
+		}
+
+		m := &snapshotMapping{
+			Dir:      absDir,
+			Path:     snapshotDir,
+			Snapshot: snapshoted,
+		}
+
+		if c.PrivateMounts {
+			// The snapshot path is of the real dir, so it is mounted over the real dir, and the original path
+			// still reaches it through any symbolic links
+			realDir, err := filepath.EvalSymlinks(absDir)
+			if err != nil {
+				return err
+			}
+
+			if snapshoted {
+				mounts[realDir] = snapshotDir
+			}
+
+			// The snapshots will be mounted over the original paths
+			m.Path = absDir
 		}
 
-		snapshotDirs = append(snapshotDirs, snapshotDir)
+		mappings = append(mappings, m)
+		c.mappings = mappings
+	}
+
+	err = printReport(ctx, backuper.Report(), c.Report)
+	if err != nil {
+		return err
 	}
 
 	ctx.console.Print("")
 
+	if abortErr != nil {
+		return abortErr
+	}
+
+	if len(mappings) == 0 {
+		return errors.New("All directories were skipped")
+	}
+
 	if cmd != nil {
-		cmd.Args = append(cmd.Args, snapshotDirs...)
+		args, found, err := expandPlaceholders(cmd.Args, mappings)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			for _, m := range mappings {
+				args = append(args, m.Path)
+			}
+		}
+
+		cmd.Args = args
+
+		env, err := createSnapshotEnv(mappings)
+		if err != nil {
+			return err
+		}
+
+		cmd.Env = append(cmd.Env, env...)
 
 		if ctx.globals.Verbose >= 1 {
 			ctx.console.Printf("Executing: '%v'", strings.Join(cmd.Args, "' '"))
 			ctx.console.Print("")
 		}
 
-		err = cmd.Run()
+		if c.PrivateMounts {
+			err = fs_snapshot.StartWithSnapshotMounts(cmd, mounts, ctx.console.NewInfoMessageCallback())
+			if err == nil {
+				err = cmd.Wait()
+			}
+		} else {
+			err = cmd.Run()
+		}
 
 		ctx.console.Print("")
 
+		failedErr := checkFailedSnapshots(ctx, backuper)
+
 		if err != nil {
 			return errors.Wrap(err, "Error executing command")
 		}
 
-		return nil
+		return failedErr
 
 	} else {
-		fmt.Print("Press <enter> to finish backup and delete snapshot(s)")
+		fmt.Fprint(ctx.console.out, "Press <enter> to finish backup and delete snapshot(s)")
 
 		var response string
 		_, _ = fmt.Scanln(&response)
 
+		return checkFailedSnapshots(ctx, backuper)
+	}
+}
+
+// checkFailedSnapshots returns an error if a snapshot became invalid, because the data read from it is not reliable.
+func checkFailedSnapshots(ctx *context, backuper fs_snapshot.Backuper) error {
+	failures := backuper.FailedSnapshots()
+	if len(failures) == 0 {
 		return nil
 	}
+
+	for _, f := range failures {
+		if f.Snapshot != nil {
+			ctx.console.Printf("%v: Snapshot became invalid during the backup: %v", f.Snapshot.OriginalDir, f.Reason)
+		} else {
+			ctx.console.Printf("Snapshots may have become invalid during the backup: %v", f.Reason)
+		}
+	}
+
+	return errors.Errorf("The backup is not reliable: %v snapshot(s) became invalid while it was running", len(failures))
 }
 
-func (c *backupCmd) createExecCommand() (*exec.Cmd, error) {
+// createMonitorConfig returns nil if not monitoring.
+func (c *backupCmd) createMonitorConfig() (*fs_snapshot.MonitorConfig, error) {
+	if !c.Monitor {
+		if c.AutoExtend > 0 {
+			return nil, errors.New("--auto-extend needs --monitor")
+		}
+
+		return nil, nil
+	}
+
+	return &fs_snapshot.MonitorConfig{
+		AutoExtend: c.AutoExtend > 0,
+		ExtendSize: int64(c.AutoExtend),
+	}, nil
+}
+
+// createRetryConfig returns nil if not retrying.
+func (c *backupCmd) createRetryConfig() (*fs_snapshot.RetryConfig, error) {
+	if c.RetryAttempts <= 0 {
+		if c.RetryBackoff != 0 || c.RetryAllErrors {
+			return nil, errors.New("--retry-backoff and --retry-all-errors need --retry-attempts")
+		}
+
+		return nil, nil
+	}
+
+	if c.RetryBackoff < 0 {
+		return nil, errors.New("--retry-backoff must not be negative")
+	}
+
+	return &fs_snapshot.RetryConfig{
+		Attempts:       c.RetryAttempts,
+		Backoff:        c.RetryBackoff,
+		RetryAllErrors: c.RetryAllErrors,
+	}, nil
+}
+
+// createFreeSpaceConfig returns nil if no limit was set.
+func (c *backupCmd) createFreeSpaceConfig() (*fs_snapshot.FreeSpaceConfig, error) {
+	if c.MinFreeSpace == 0 && c.MinFreePercent == 0 && c.MaxSnapshotsSize == 0 {
+		return nil, nil
+	}
+
+	if c.MinFreePercent < 0 || c.MinFreePercent > 100 {
+		return nil, errors.New("--min-free-percent must be between 0 and 100")
+	}
+
+	action, err := fs_snapshot.ParseFreeSpaceAction(c.FreeSpaceAction)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fs_snapshot.FreeSpaceConfig{
+		MinFreeSpace:       int64(c.MinFreeSpace),
+		MinFreePercent:     c.MinFreePercent,
+		MaxSnapshotsSize:   int64(c.MaxSnapshotsSize),
+		Action:             action,
+		ProtectedSnapshots: c.Protect,
+	}, nil
+}
+
+func (c *backupCmd) createExecCommand(ctx *context) (*exec.Cmd, error) {
 	if c.Exec == "" {
 		return nil, nil
 	}
 
-	envs, args, err := shellwords.ParseWithEnvs(c.Exec)
+	cmd, err := createCommand(c.Exec, c.NoShell, ctx.console.out)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd.Env = append(cmd.Env, c.env...)
+
+	return cmd, nil
+}
+
+// createCommand parses a command line to execute. The output goes to stdout, and errors to os.Stderr.
+func createCommand(line string, noShell bool, stdout io.Writer) (*exec.Cmd, error) {
+	envs, args, err := shellwords.ParseWithEnvs(line)
 	if err != nil {
 		return nil, errors.Wrap(err, "Error parsing command to execute")
 	}
+	if len(args) == 0 {
+		return nil, errors.New("Empty command to execute")
+	}
 
-	if !c.NoShell {
+	if !noShell {
 		if runtime.GOOS == "windows" {
 			args = append([]string{os.Getenv("COMSPEC"), "/c"}, args...)
 		} else {
@@ -111,7 +349,7 @@ func (c *backupCmd) createExecCommand() (*exec.Cmd, error) {
 	}
 
 	cmd.Stdin = os.Stdin
-	cmd.Stdout = os.Stdout
+	cmd.Stdout = stdout
 	cmd.Stderr = os.Stderr
 	cmd.Env = append(os.Environ(), envs...)
 
@@ -0,0 +1,20 @@
+package main
+
+type cleanupCmd struct {
+	ServerArgs serverArgs `embed:""`
+}
+
+func (c *cleanupCmd) Run(ctx *context) error {
+	cleaned, err := ctx.snapshoter.CleanupTemporarySnapshots()
+	if err != nil {
+		return err
+	}
+
+	if cleaned == 0 {
+		ctx.console.Print("No orphaned temporary snapshots found.")
+	} else {
+		ctx.console.Printf("Deleted %v orphaned temporary snapshots and mounts.", cleaned)
+	}
+
+	return nil
+}
@@ -2,6 +2,8 @@ package main
 
 import (
 	"fmt"
+	"io"
+	"os"
 	"strings"
 
 	"github.com/pescuma/go-fs-snapshot/lib/fs_snapshot"
@@ -9,6 +11,7 @@ import (
 
 type console struct {
 	verbosity int
+	out       io.Writer
 
 	lastLevel             fs_snapshot.MessageLevel
 	lastLineWasSeparation bool
@@ -17,6 +20,7 @@ type console struct {
 func newConsole(verbosity int) *console {
 	return &console{
 		verbosity: verbosity,
+		out:       os.Stdout,
 		lastLevel: -1,
 	}
 }
@@ -52,18 +56,18 @@ func (c *console) Printl(level fs_snapshot.MessageLevel, msg string) {
 
 	switch level {
 	case fs_snapshot.OutputLevel:
-		fmt.Println(msg)
+		fmt.Fprintln(c.out, msg)
 
 	case fs_snapshot.InfoLevel:
-		fmt.Println(msg)
+		fmt.Fprintln(c.out, msg)
 
 	case fs_snapshot.DetailsLevel:
-		fmt.Println(msg)
+		fmt.Fprintln(c.out, msg)
 
 	case fs_snapshot.TraceLevel:
 		msgs := strings.Split(msg, "\n")
 		for _, m := range msgs {
-			fmt.Println("[TRACE] " + m)
+			fmt.Fprintln(c.out, "[TRACE] "+m)
 		}
 	}
 
@@ -73,7 +77,7 @@ func (c *console) Printl(level fs_snapshot.MessageLevel, msg string) {
 func (c *console) AskForConfirmation(message string) bool {
 	c.printLevelSeparation(fs_snapshot.OutputLevel)
 
-	fmt.Printf("%s [y/N] ", message)
+	fmt.Fprintf(c.out, "%s [y/N] ", message)
 
 	c.lastLineWasSeparation = false
 
@@ -90,14 +94,14 @@ func (c *console) AskForConfirmation(message string) bool {
 	} else if response == "" || response == "n" || response == "no" {
 		return false
 	} else {
-		fmt.Printf("Unknown answer, considering it as NO")
+		fmt.Fprintf(c.out, "Unknown answer, considering it as NO")
 		return false
 	}
 }
 
 func (c *console) printLevelSeparation(level fs_snapshot.MessageLevel) {
 	if c.lastLevel != -1 && c.lastLevel != level && !c.lastLineWasSeparation {
-		fmt.Println()
+		fmt.Fprintln(c.out)
 		c.lastLineWasSeparation = true
 	}
 	c.lastLevel = level
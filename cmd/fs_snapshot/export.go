@@ -0,0 +1,89 @@
+package main
+
+import (
+	"io"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/pescuma/go-fs-snapshot/lib/fs_snapshot"
+)
+
+type exportCmd struct {
+	Source string `arg:"" name:"snapshot-id|dir" help:"The ID (simplified or full) of an existing snapshot, or a directory to snapshot and export."`
+	Format string `enum:"tar,tar.zst,zip" default:"tar" help:"Archive format (tar, tar.zst or zip)."`
+	Output string `short:"o" required:"" help:"File to write the archive to, or - for stdout."`
+
+	ProviderID string        `help:"Select which provider to use."`
+	Timeout    time.Duration `help:"Timeout to create snapshot."`
+	Simple     bool          `help:"Try to do it as simple as possible, but not simpler. In Windows this means do not use VSS Writers."`
+
+	ServerArgs serverArgs `embed:""`
+}
+
+func (c *exportCmd) WritesToStdout() bool {
+	return c.Output == "-"
+}
+
+func (c *exportCmd) Run(ctx *context) (err error) {
+	format, err := fs_snapshot.ParseArchiveFormat(c.Format)
+	if err != nil {
+		return err
+	}
+
+	files, originalDir, closeSource, err := openSnapshotSource(ctx, c.Source, &fs_snapshot.BackupConfig{
+		ProviderID: c.ProviderID,
+		Timeout:    c.Timeout,
+		Simple:     c.Simple,
+	})
+	if err != nil {
+		return err
+	}
+	if files == nil {
+		return nil
+	}
+
+	// Deletes the temporary snapshot after the archive is written
+	defer closeSource()
+
+	var out io.Writer
+	if c.Output == "-" {
+		out = os.Stdout
+
+	} else {
+		file, createErr := os.Create(c.Output)
+		if createErr != nil {
+			return errors.Wrap(createErr, "Error creating output file")
+		}
+
+		defer func() {
+			// Closing flushes the data, so it can fail when the disk is full
+			closeErr := file.Close()
+			if err == nil && closeErr != nil {
+				err = errors.Wrap(closeErr, "Error writing output file")
+			}
+
+			// Don't leave incomplete archives behind
+			if err != nil {
+				_ = os.Remove(c.Output)
+			}
+		}()
+
+		out = file
+	}
+
+	err = fs_snapshot.ExportArchive(out, files, originalDir, &fs_snapshot.ExportConfig{
+		Format:       format,
+		InfoCallback: ctx.console.NewInfoMessageCallback(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if c.Output != "-" {
+		ctx.console.Printf("Exported %v to %v", originalDir, c.Output)
+	}
+
+	return nil
+}
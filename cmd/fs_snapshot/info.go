@@ -1,6 +1,8 @@
 package main
 
 import (
+	"os"
+
 	"github.com/pescuma/go-fs-snapshot/lib/fs_snapshot"
 )
 
@@ -44,9 +46,45 @@ func findOneSnapshot(ctx *context, id string) (*fs_snapshot.Snapshot, error) {
 	}
 }
 
+// openSnapshotSource returns the files of an existing snapshot, or of a temporary snapshot of a dir, and the
+// original dir. The returned func deletes the temporary snapshot. files is nil if the snapshot was not found.
+func openSnapshotSource(ctx *context, source string, cfg *fs_snapshot.BackupConfig) (fs_snapshot.FS, string, func(), error) {
+	if s, err := os.Stat(source); err == nil && s.IsDir() {
+		backuper, err := ctx.snapshoter.StartBackup(cfg)
+		if err != nil {
+			return nil, "", nil, err
+		}
+
+		files, err := backuper.FS(source)
+		if err != nil {
+			backuper.Close()
+			return nil, "", nil, err
+		}
+
+		if _, ok := backuper.ToSnapshotPath(source); !ok {
+			ctx.console.Printlf(fs_snapshot.InfoLevel,
+				"WARNING: no snapshot was created for %v, using the live files instead (they may change while being read)", source)
+		}
+
+		return files, source, backuper.Close, nil
+	}
+
+	snapshot, err := findOneSnapshot(ctx, source)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	if snapshot == nil {
+		return nil, "", nil, nil
+	}
+
+	return snapshot.FS(), snapshot.OriginalDir, func() {}, nil
+}
+
 func printSnapshotInfo(ctx *context, snapshot *fs_snapshot.Snapshot, prefix string) {
 	ctx.console.Printf("%vID:           %v", prefix, snapshot.ID)
-	ctx.console.Printf("%vSet ID:       %v", prefix, snapshot.Set.ID)
+	if snapshot.Set != nil {
+		ctx.console.Printf("%vSet ID:       %v", prefix, snapshot.Set.ID)
+	}
 	ctx.console.Printf("%vOriginal dir: %v", prefix, snapshot.OriginalDir)
 	ctx.console.Printf("%vSnapshot dir: %v", prefix, snapshot.SnapshotDir)
 	ctx.console.Printf("%vCreation:     %v", prefix, snapshot.CreationTime.Local().Format("2006-01-02 15:04:05 -07"))
@@ -0,0 +1,418 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/mattn/go-shellwords"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/pescuma/go-fs-snapshot/lib/fs_snapshot"
+)
+
+// jobsConfig is the config file used by the run command.
+type jobsConfig struct {
+	// Defaults are used by all jobs, for the fields they don't set
+	Defaults jobConfig             `yaml:"defaults" toml:"defaults"`
+	Jobs     map[string]*jobConfig `yaml:"jobs" toml:"jobs"`
+}
+
+type jobConfig struct {
+	Server               string `yaml:"server" toml:"server"`
+	ServerOnlyAsFallback *bool  `yaml:"server-only-as-fallback" toml:"server-only-as-fallback"`
+	ServerAuth           string `yaml:"server-auth" toml:"server-auth"`
+	ServerCredentials    string `yaml:"server-credentials" toml:"server-credentials"`
+
+	Dirs []string `yaml:"dirs" toml:"dirs"`
+	// Providers are tried in order, and the first available one is used
+	Providers []string     `yaml:"providers" toml:"providers"`
+	Timeout   *jobDuration `yaml:"timeout" toml:"timeout"`
+	Simple    *bool        `yaml:"simple" toml:"simple"`
+
+	Pre           []string `yaml:"pre" toml:"pre"`
+	Exec          string   `yaml:"exec" toml:"exec"`
+	Post          []string `yaml:"post" toml:"post"`
+	NoShell       *bool    `yaml:"no-shell" toml:"no-shell"`
+	PrivateMounts *bool    `yaml:"private-mounts" toml:"private-mounts"`
+
+	MinFreeSpace       *byteSize `yaml:"min-free-space" toml:"min-free-space"`
+	MinFreePercent     *float64  `yaml:"min-free-percent" toml:"min-free-percent"`
+	MaxSnapshotsSize   *byteSize `yaml:"max-snapshots-size" toml:"max-snapshots-size"`
+	FreeSpaceAction    string    `yaml:"free-space-action" toml:"free-space-action"`
+	ProtectedSnapshots []string  `yaml:"protected-snapshots" toml:"protected-snapshots"`
+
+	Monitor    *bool     `yaml:"monitor" toml:"monitor"`
+	AutoExtend *byteSize `yaml:"auto-extend" toml:"auto-extend"`
+
+	FailurePolicy  string       `yaml:"failure-policy" toml:"failure-policy"`
+	RetryAttempts  *int         `yaml:"retry-attempts" toml:"retry-attempts"`
+	RetryBackoff   *jobDuration `yaml:"retry-backoff" toml:"retry-backoff"`
+	RetryAllErrors *bool        `yaml:"retry-all-errors" toml:"retry-all-errors"`
+
+	Output string `yaml:"output" toml:"output"`
+}
+
+const (
+	outputText = "text"
+	outputJson = "json"
+)
+
+// jobDuration accepts durations in the time.ParseDuration format, like 5m or 1h30m.
+type jobDuration time.Duration
+
+func (d *jobDuration) UnmarshalText(text []byte) error {
+	r, err := time.ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+
+	*d = jobDuration(r)
+	return nil
+}
+
+// defaultJobsConfigFiles returns the files used when --config is not set, in the order they are searched.
+func defaultJobsConfigFiles() []string {
+	var dirs []string
+
+	dirs = append(dirs, ".")
+
+	if dir, err := os.UserConfigDir(); err == nil {
+		dirs = append(dirs, filepath.Join(dir, "fs_snapshot"))
+	}
+
+	var result []string
+	for _, dir := range dirs {
+		for _, name := range []string{"fs_snapshot.yaml", "fs_snapshot.yml", "fs_snapshot.toml"} {
+			result = append(result, filepath.Join(dir, name))
+		}
+	}
+
+	return result
+}
+
+func findJobsConfigFile() (string, error) {
+	files := defaultJobsConfigFiles()
+
+	for _, file := range files {
+		if _, err := os.Stat(file); err == nil {
+			return file, nil
+		}
+	}
+
+	return "", errors.Errorf("config file not found, use --config or create one of: %v", strings.Join(files, ", "))
+}
+
+// loadJobsConfig reads a YAML or TOML config file (based on the extension) and validates all the jobs in it.
+func loadJobsConfig(file string) (*jobsConfig, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading config file")
+	}
+
+	var result jobsConfig
+
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".yaml", ".yml":
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+
+		err = decoder.Decode(&result)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%v: invalid config", file)
+		}
+
+	case ".toml":
+		md, err := toml.Decode(string(data), &result)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%v: invalid config", file)
+		}
+
+		if undecoded := md.Undecoded(); len(undecoded) > 0 {
+			return nil, errors.Errorf("%v: unknown field: %v", file, undecoded[0].String())
+		}
+
+	default:
+		return nil, errors.Errorf("%v: unknown config format, use a .yaml, .yml or .toml extension", file)
+	}
+
+	err = result.validate()
+	if err != nil {
+		return nil, errors.Wrap(err, file)
+	}
+
+	return &result, nil
+}
+
+func (c *jobsConfig) validate() error {
+	if len(c.Jobs) == 0 {
+		return errors.New("no jobs defined")
+	}
+
+	if len(c.Defaults.Dirs) > 0 {
+		return errors.New("defaults: dirs must be defined in each job")
+	}
+
+	for _, name := range c.jobNames() {
+		job := c.Jobs[name]
+		if job == nil {
+			return errors.Errorf("job %v: is empty", name)
+		}
+
+		err := c.job(name).validate()
+		if err != nil {
+			return errors.Wrapf(err, "job %v", name)
+		}
+	}
+
+	return nil
+}
+
+func (c *jobsConfig) jobNames() []string {
+	var result []string
+	for name := range c.Jobs {
+		result = append(result, name)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// job returns the config of a job merged with the defaults, or nil if it does not exist.
+func (c *jobsConfig) job(name string) *jobConfig {
+	job, ok := c.Jobs[name]
+	if !ok || job == nil {
+		return nil
+	}
+
+	d := &c.Defaults
+	result := *job
+
+	if result.Server == "" {
+		result.Server = d.Server
+	}
+	if result.ServerOnlyAsFallback == nil {
+		result.ServerOnlyAsFallback = d.ServerOnlyAsFallback
+	}
+	if result.ServerAuth == "" {
+		result.ServerAuth = d.ServerAuth
+	}
+	if result.ServerCredentials == "" {
+		result.ServerCredentials = d.ServerCredentials
+	}
+	if result.Providers == nil {
+		result.Providers = d.Providers
+	}
+	if result.Timeout == nil {
+		result.Timeout = d.Timeout
+	}
+	if result.Simple == nil {
+		result.Simple = d.Simple
+	}
+	if result.Pre == nil {
+		result.Pre = d.Pre
+	}
+	if result.Exec == "" {
+		result.Exec = d.Exec
+	}
+	if result.Post == nil {
+		result.Post = d.Post
+	}
+	if result.NoShell == nil {
+		result.NoShell = d.NoShell
+	}
+	if result.PrivateMounts == nil {
+		result.PrivateMounts = d.PrivateMounts
+	}
+	if result.MinFreeSpace == nil {
+		result.MinFreeSpace = d.MinFreeSpace
+	}
+	if result.MinFreePercent == nil {
+		result.MinFreePercent = d.MinFreePercent
+	}
+	if result.MaxSnapshotsSize == nil {
+		result.MaxSnapshotsSize = d.MaxSnapshotsSize
+	}
+	if result.FreeSpaceAction == "" {
+		result.FreeSpaceAction = d.FreeSpaceAction
+	}
+	if result.ProtectedSnapshots == nil {
+		result.ProtectedSnapshots = d.ProtectedSnapshots
+	}
+	if result.Monitor == nil {
+		result.Monitor = d.Monitor
+	}
+	if result.AutoExtend == nil {
+		result.AutoExtend = d.AutoExtend
+	}
+	if result.FailurePolicy == "" {
+		result.FailurePolicy = d.FailurePolicy
+	}
+	if result.RetryAttempts == nil {
+		result.RetryAttempts = d.RetryAttempts
+	}
+	if result.RetryBackoff == nil {
+		result.RetryBackoff = d.RetryBackoff
+	}
+	if result.RetryAllErrors == nil {
+		result.RetryAllErrors = d.RetryAllErrors
+	}
+	if result.Output == "" {
+		result.Output = d.Output
+	}
+
+	result.setDefaults()
+
+	return &result
+}
+
+func (j *jobConfig) setDefaults() {
+	if j.ServerAuth == "" {
+		j.ServerAuth = "default"
+	}
+	if j.FreeSpaceAction == "" {
+		j.FreeSpaceAction = fs_snapshot.FreeSpaceRefuse.String()
+	}
+	if j.FailurePolicy == "" {
+		j.FailurePolicy = fs_snapshot.FailurePolicyFallback.String()
+	}
+	if j.Output == "" {
+		j.Output = outputText
+	}
+}
+
+func (j *jobConfig) validate() error {
+	if j.Server != "" && !strings.HasPrefix(j.Server, "unix:") {
+		_, _, err := parseAddr(j.Server)
+		if err != nil {
+			return errors.Wrap(err, "server")
+		}
+	}
+
+	_, err := fs_snapshot.ParseAuthMode(j.ServerAuth)
+	if err != nil {
+		return errors.Wrap(err, "server-auth")
+	}
+
+	if len(j.Dirs) == 0 {
+		return errors.New("dirs: at least one dir is needed")
+	}
+	for _, dir := range j.Dirs {
+		if dir == "" {
+			return errors.New("dirs: empty dir")
+		}
+	}
+
+	if j.Timeout != nil && *j.Timeout < 0 {
+		return errors.New("timeout: must not be negative")
+	}
+
+	if j.Exec == "" {
+		return errors.New("exec: the command to execute is needed")
+	}
+
+	_, args, err := shellwords.ParseWithEnvs(j.Exec)
+	if err != nil {
+		return errors.Wrap(err, "exec")
+	}
+	if len(args) == 0 {
+		return errors.New("exec: the command to execute is needed")
+	}
+
+	// Checks the placeholders, using the original dirs as snapshot paths
+	var mappings []*snapshotMapping
+	for _, dir := range j.Dirs {
+		abs, err := filepath.Abs(dir)
+		if err != nil {
+			return errors.Wrap(err, "dirs")
+		}
+
+		mappings = append(mappings, &snapshotMapping{Dir: abs, Path: abs})
+	}
+
+	_, _, err = expandPlaceholders(args, mappings)
+	if err != nil {
+		return errors.Wrap(err, "exec")
+	}
+
+	for _, hook := range j.Pre {
+		if strings.TrimSpace(hook) == "" {
+			return errors.New("pre: empty command")
+		}
+	}
+	for _, hook := range j.Post {
+		if strings.TrimSpace(hook) == "" {
+			return errors.New("post: empty command")
+		}
+	}
+
+	if j.PrivateMounts != nil && *j.PrivateMounts && runtime.GOOS != "linux" {
+		return errors.New("private-mounts: only supported in Linux")
+	}
+
+	if j.MinFreePercent != nil && (*j.MinFreePercent < 0 || *j.MinFreePercent > 100) {
+		return errors.New("min-free-percent: must be between 0 and 100")
+	}
+
+	if j.AutoExtend != nil && *j.AutoExtend > 0 && (j.Monitor == nil || !*j.Monitor) {
+		return errors.New("auto-extend: needs monitor")
+	}
+
+	_, err = fs_snapshot.ParseFreeSpaceAction(j.FreeSpaceAction)
+	if err != nil {
+		return errors.Wrap(err, "free-space-action")
+	}
+
+	_, err = fs_snapshot.ParseFailurePolicy(j.FailurePolicy)
+	if err != nil {
+		return errors.Wrap(err, "failure-policy")
+	}
+
+	if j.RetryAttempts != nil && *j.RetryAttempts < 0 {
+		return errors.New("retry-attempts: must not be negative")
+	}
+	if j.RetryBackoff != nil && *j.RetryBackoff < 0 {
+		return errors.New("retry-backoff: must not be negative")
+	}
+	hasRetries := j.RetryAttempts != nil && *j.RetryAttempts > 0
+	if !hasRetries && (j.RetryBackoff != nil || (j.RetryAllErrors != nil && *j.RetryAllErrors)) {
+		return errors.New("retry-backoff and retry-all-errors: need retry-attempts")
+	}
+
+	switch j.Output {
+	case outputText, outputJson:
+	default:
+		return errors.Errorf("output: must be %v or %v, not %v", outputText, outputJson, j.Output)
+	}
+
+	return nil
+}
+
+// checkDirs checks that the dirs exist. It is only done for the job being run, because the other ones may be
+// for dirs that exist only sometimes (like external disks).
+func (j *jobConfig) checkDirs() error {
+	for _, dir := range j.Dirs {
+		err := checkSnapshotPath(dir)
+		if err != nil {
+			return errors.Wrap(err, "dirs")
+		}
+	}
+
+	return nil
+}
+
+func (j *jobConfig) serverArgs() *serverArgs {
+	return &serverArgs{
+		Server:               j.Server,
+		ServerOnlyAsFallback: j.ServerOnlyAsFallback != nil && *j.ServerOnlyAsFallback,
+		ServerAuth:           j.ServerAuth,
+		ServerCredentials:    j.ServerCredentials,
+	}
+}
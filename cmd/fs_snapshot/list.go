@@ -1,12 +1,17 @@
 package main
 
 import (
+	"fmt"
 	"sort"
 
 	"github.com/alexeyco/simpletable"
+
+	"github.com/pescuma/go-fs-snapshot/lib/fs_snapshot"
 )
 
 type listCmd struct {
+	Sizes bool `help:"Show the space used by the snapshots and a summary of each volume"`
+
 	ServerArgs serverArgs `embed:""`
 }
 
@@ -46,6 +51,14 @@ func (c *listCmd) Run(ctx *context) error {
 		}...)
 	}
 
+	if c.Sizes {
+		table.Header.Cells = append(table.Header.Cells, []*simpletable.Cell{
+			{Text: "Exclusive"},
+			{Text: "Shared"},
+			{Text: "COW usage"},
+		}...)
+	}
+
 	sort.Slice(ps, func(a, b int) bool {
 		return ps[a].CreationTime.After(ps[b].CreationTime)
 	})
@@ -85,8 +98,81 @@ func (c *listCmd) Run(ctx *context) error {
 				{Text: p.Attributes},
 			})
 		}
+
+		if c.Sizes {
+			row := &table.Body.Cells[len(table.Body.Cells)-1]
+			*row = append(*row, sizeCells(p.Size)...)
+		}
+	}
+
+	ctx.console.Print(table.String())
+
+	if c.Sizes {
+		return c.printVolumes(ctx, ps)
+	}
+
+	return nil
+}
+
+func sizeCells(size *fs_snapshot.SnapshotSize) []*simpletable.Cell {
+	if size == nil {
+		return []*simpletable.Cell{
+			{Text: "-"},
+			{Text: "-"},
+			{Text: "-"},
+		}
+	}
+
+	cowUsage := "-"
+	if size.CowUsage >= 0 {
+		cowUsage = fmt.Sprintf("%.1f%%", size.CowUsage)
+	}
+
+	return []*simpletable.Cell{
+		{Text: fs_snapshot.FormatSize(size.Exclusive), Align: simpletable.AlignRight},
+		{Text: fs_snapshot.FormatSize(size.Shared), Align: simpletable.AlignRight},
+		{Text: cowUsage, Align: simpletable.AlignRight},
+	}
+}
+
+func (c *listCmd) printVolumes(ctx *context, ps []*fs_snapshot.Snapshot) error {
+	var volumes []string
+	seen := map[string]bool{}
+	for _, p := range ps {
+		if !seen[p.OriginalDir] {
+			seen[p.OriginalDir] = true
+			volumes = append(volumes, p.OriginalDir)
+		}
+	}
+	sort.Strings(volumes)
+
+	table := simpletable.New()
+	table.SetStyle(simpletable.StyleCompactLite)
+
+	table.Header.Cells = append(table.Header.Cells, []*simpletable.Cell{
+		{Text: "Volume"},
+		{Text: "Total"},
+		{Text: "Free"},
+		{Text: "Snapshots"},
+		{Text: "Snapshots max"},
+	}...)
+
+	for _, volume := range volumes {
+		usage, err := ctx.snapshoter.GetVolumeUsage(volume)
+		if err != nil {
+			return err
+		}
+
+		table.Body.Cells = append(table.Body.Cells, []*simpletable.Cell{
+			{Text: usage.Volume},
+			{Text: fs_snapshot.FormatSize(usage.TotalSize), Align: simpletable.AlignRight},
+			{Text: fs_snapshot.FormatSize(usage.FreeSpace), Align: simpletable.AlignRight},
+			{Text: fs_snapshot.FormatSize(usage.SnapshotsSize), Align: simpletable.AlignRight},
+			{Text: fs_snapshot.FormatSize(usage.SnapshotsMaxSize), Align: simpletable.AlignRight},
+		})
 	}
 
+	ctx.console.Print("")
 	ctx.console.Print(table.String())
 
 	return nil
@@ -1,7 +1,9 @@
 package main
 
 import (
+	"os"
 	"reflect"
+	"strings"
 
 	"github.com/alecthomas/kong"
 
@@ -25,11 +27,28 @@ func main() {
 func execute(ctx *kong.Context, gs *globals) error {
 	c := newConsole(gs.Verbose)
 
+	// The output is the data, so messages must go somewhere else
+	if w, ok := getCommand(ctx).(writesToStdout); ok && w.WritesToStdout() {
+		c.out = os.Stderr
+	}
+
 	var s fs_snapshot.Snapshoter
 
 	sa := getServerArgs(ctx)
 	if sa != nil {
-		ip, port, err := parseAddr(sa.Server)
+		var ip, socket string
+		var port int
+		var err error
+		if strings.HasPrefix(sa.Server, "unix:") {
+			socket = strings.TrimPrefix(sa.Server, "unix:")
+		} else {
+			ip, port, err = parseAddr(sa.Server)
+			if err != nil {
+				return err
+			}
+		}
+
+		auth, err := fs_snapshot.ParseAuthMode(sa.ServerAuth)
 		if err != nil {
 			return err
 		}
@@ -40,10 +59,13 @@ func execute(ctx *kong.Context, gs *globals) error {
 		}
 
 		s, err = fs_snapshot.NewSnapshoter(&fs_snapshot.SnapshoterConfig{
-			InfoCallback:   c.NewInfoMessageCallback(),
-			ConnectionType: ct,
-			ServerIP:       ip,
-			ServerPort:     port,
+			InfoCallback:         c.NewInfoMessageCallback(),
+			ConnectionType:       ct,
+			ServerIP:             ip,
+			ServerPort:           port,
+			ServerSocket:         socket,
+			ServerAuth:           auth,
+			ServerCredentialsDir: sa.ServerCredentials,
 		})
 		if err != nil {
 			return err
@@ -59,7 +81,21 @@ func execute(ctx *kong.Context, gs *globals) error {
 	})
 }
 
-func getServerArgs(ctx *kong.Context) *serverArgs {
+// writesToStdout is implemented by commands that can write their output data to stdout.
+type writesToStdout interface {
+	WritesToStdout() bool
+}
+
+func getCommand(ctx *kong.Context) interface{} {
+	cmd := getCommandValue(ctx)
+	if !cmd.IsValid() || !cmd.CanAddr() {
+		return nil
+	}
+
+	return cmd.Addr().Interface()
+}
+
+func getCommandValue(ctx *kong.Context) reflect.Value {
 	var cmd reflect.Value
 
 	for _, p := range ctx.Path {
@@ -68,6 +104,21 @@ func getServerArgs(ctx *kong.Context) *serverArgs {
 		}
 	}
 
+	return cmd
+}
+
+// serverArgsSource is implemented by commands that get the server args from somewhere else than the command line.
+type serverArgsSource interface {
+	GetServerArgs() *serverArgs
+}
+
+func getServerArgs(ctx *kong.Context) *serverArgs {
+	if s, ok := getCommand(ctx).(serverArgsSource); ok {
+		return s.GetServerArgs()
+	}
+
+	cmd := getCommandValue(ctx)
+
 	if !cmd.IsValid() {
 		return nil
 	}
@@ -90,11 +141,16 @@ type context struct {
 type commands struct {
 	Globals globals `embed:""`
 
-	Version versionCmd `cmd:"" help:"Print version information."`
-	List    listCmd    `cmd:"" help:"List snapshots."`
-	Info    infoCmd    `cmd:"" help:"Show information of a snapshot."`
-	Delete  deleteCmd  `cmd:"" help:"Delete a snapshot."`
-	Backup  backupCmd  `cmd:"" help:"Create snapshots to do a backup."`
+	Version  versionCmd  `cmd:"" help:"Print version information."`
+	List     listCmd     `cmd:"" help:"List snapshots."`
+	Info     infoCmd     `cmd:"" help:"Show information of a snapshot."`
+	Delete   deleteCmd   `cmd:"" help:"Delete a snapshot."`
+	Backup   backupCmd   `cmd:"" help:"Create snapshots to do a backup."`
+	Run      runCmd      `cmd:"" help:"Run a backup job defined in a config file."`
+	Export   exportCmd   `cmd:"" help:"Export a snapshot of a directory as an archive."`
+	Manifest manifestCmd `cmd:"" help:"Create a manifest with the hashes of the files in a snapshot."`
+	Verify   verifyCmd   `cmd:"" help:"Verify a snapshot or a directory against a manifest."`
+	Cleanup  cleanupCmd  `cmd:"" help:"Delete temporary snapshots left behind by backups that did not finish."`
 
 	Provider struct {
 		List providerListCmd `cmd:"" help:"List available snapshot providers."`
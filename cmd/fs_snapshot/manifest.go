@@ -0,0 +1,81 @@
+package main
+
+import (
+	"io"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/pescuma/go-fs-snapshot/lib/fs_snapshot"
+)
+
+type manifestCmd struct {
+	Source      string `arg:"" name:"snapshot-id|dir" help:"The ID (simplified or full) of an existing snapshot, or a directory to snapshot and hash."`
+	Output      string `short:"o" default:"-" help:"File to write the manifest to, or - for stdout."`
+	Concurrency int    `help:"Number of files to hash at the same time. Default is the number of CPUs."`
+
+	ProviderID string        `help:"Select which provider to use."`
+	Timeout    time.Duration `help:"Timeout to create snapshot."`
+	Simple     bool          `help:"Try to do it as simple as possible, but not simpler. In Windows this means do not use VSS Writers."`
+
+	ServerArgs serverArgs `embed:""`
+}
+
+func (c *manifestCmd) WritesToStdout() bool {
+	return c.Output == "-"
+}
+
+func (c *manifestCmd) Run(ctx *context) (err error) {
+	files, originalDir, closeSource, err := openSnapshotSource(ctx, c.Source, &fs_snapshot.BackupConfig{
+		ProviderID: c.ProviderID,
+		Timeout:    c.Timeout,
+		Simple:     c.Simple,
+	})
+	if err != nil {
+		return err
+	}
+	if files == nil {
+		return nil
+	}
+
+	defer closeSource()
+
+	manifest, err := fs_snapshot.CreateManifest(files, originalDir, &fs_snapshot.ManifestConfig{
+		Concurrency:  c.Concurrency,
+		InfoCallback: ctx.console.NewInfoMessageCallback(),
+	})
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer
+	if c.Output == "-" {
+		out = os.Stdout
+
+	} else {
+		file, createErr := os.Create(c.Output)
+		if createErr != nil {
+			return errors.Wrap(createErr, "Error creating output file")
+		}
+
+		defer func() {
+			_ = file.Close()
+
+			if err != nil {
+				_ = os.Remove(c.Output)
+			}
+		}()
+
+		out = file
+	}
+
+	err = fs_snapshot.WriteManifest(out, manifest)
+	if err != nil {
+		return err
+	}
+
+	ctx.console.Printf("%v: %v entries, root hash %v", originalDir, len(manifest.Entries), manifest.Root)
+
+	return nil
+}
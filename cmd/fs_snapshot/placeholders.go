@@ -0,0 +1,135 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// snapshotMapping says where the command can find the data of a backed up directory.
+type snapshotMapping struct {
+	Dir string `json:"dir"`
+	// Path where the command sees the data. It is the original dir if it could not be snapshoted.
+	Path string `json:"path"`
+	// Snapshot is false when it fell back to the original dir.
+	Snapshot bool `json:"snapshot"`
+}
+
+func (m *snapshotMapping) status() string {
+	if m.Snapshot {
+		return "snapshot"
+	} else {
+		return "fallback"
+	}
+}
+
+// placeholderRE also matches placeholders with a doubled first brace, that are escaped.
+var placeholderRE = regexp.MustCompile(`(\{?)\{(?:(snap|orig):([^{}]*)|all)\}`)
+
+// expandPlaceholders replaces {snap:<dir>}, {orig:<dir>} and {all} in the args. {all} must be a whole arg,
+// and is replaced by the paths of all dirs. A doubled first brace escapes the placeholder: {{all} becomes {all}.
+// Returns false if there were no placeholders.
+func expandPlaceholders(args []string, mappings []*snapshotMapping) ([]string, bool, error) {
+	var result []string
+	found := false
+
+	for _, arg := range args {
+		if arg == "{all}" {
+			for _, m := range mappings {
+				result = append(result, m.Path)
+			}
+			found = true
+			continue
+		}
+
+		var err error
+		expanded := placeholderRE.ReplaceAllStringFunc(arg, func(p string) string {
+			groups := placeholderRE.FindStringSubmatch(p)
+			if groups[1] != "" {
+				return p[1:]
+			}
+
+			found = true
+
+			if groups[2] == "" {
+				err = errors.Errorf("{all} must be used as a separated argument: %v", arg)
+				return p
+			}
+
+			path, e := findMappedPath(groups[3], mappings, groups[2] == "snap")
+			if e != nil {
+				err = e
+				return p
+			}
+
+			return path
+		})
+		if err != nil {
+			return nil, false, err
+		}
+
+		result = append(result, expanded)
+	}
+
+	return result, found, nil
+}
+
+// findMappedPath returns the path of a dir that is the same or inside one of the backed up dirs.
+func findMappedPath(dir string, mappings []*snapshotMapping, snapshot bool) (string, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	var best *snapshotMapping
+	for _, m := range mappings {
+		rel, err := filepath.Rel(m.Dir, abs)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+
+		if best == nil || len(m.Dir) > len(best.Dir) {
+			best = m
+		}
+	}
+
+	if best == nil {
+		return "", errors.Errorf("%v is not inside one of the backed up directories", dir)
+	}
+
+	if !snapshot {
+		return abs, nil
+	}
+
+	rel, _ := filepath.Rel(best.Dir, abs)
+	return filepath.Join(best.Path, rel), nil
+}
+
+// createSnapshotEnv returns the environment variables with the paths: FS_SNAPSHOT_MAP has all of them as JSON,
+// and each dir has FS_SNAPSHOT_<n> (the path), FS_SNAPSHOT_<n>_ORIG and FS_SNAPSHOT_<n>_STATUS (snapshot or
+// fallback), with n starting at 1.
+func createSnapshotEnv(mappings []*snapshotMapping) ([]string, error) {
+	data, err := json.Marshal(mappings)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []string{
+		"FS_SNAPSHOT_MAP=" + string(data),
+		fmt.Sprintf("FS_SNAPSHOT_COUNT=%v", len(mappings)),
+	}
+
+	for i, m := range mappings {
+		result = append(result,
+			fmt.Sprintf("FS_SNAPSHOT_%v=%v", i+1, m.Path),
+			fmt.Sprintf("FS_SNAPSHOT_%v_ORIG=%v", i+1, m.Dir),
+			fmt.Sprintf("FS_SNAPSHOT_%v_STATUS=%v", i+1, m.status()),
+		)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func testPath(path string) string {
+	root := "/"
+	if runtime.GOOS == "windows" {
+		root = `C:\`
+	}
+
+	return filepath.Join(root, filepath.FromSlash(path))
+}
+
+func TestExpandPlaceholders(t *testing.T) {
+	mappings := []*snapshotMapping{
+		{Dir: testPath("home"), Path: testPath("snap/home"), Snapshot: true},
+		{Dir: testPath("home/user"), Path: testPath("snap2/user"), Snapshot: true},
+		{Dir: testPath("etc"), Path: testPath("etc"), Snapshot: false},
+	}
+
+	tests := []struct {
+		name  string
+		args  []string
+		want  []string
+		found bool
+	}{
+		{
+			"no placeholders",
+			[]string{"restic", "backup"},
+			[]string{"restic", "backup"},
+			false,
+		},
+		{
+			"snap",
+			[]string{"rsync", "{snap:" + testPath("home") + "}", "dest"},
+			[]string{"rsync", testPath("snap/home"), "dest"},
+			true,
+		},
+		{
+			"snap inside a dir",
+			[]string{"{snap:" + testPath("home/other/docs") + "}"},
+			[]string{testPath("snap/home/other/docs")},
+			true,
+		},
+		{
+			"snap uses the most specific dir",
+			[]string{"{snap:" + testPath("home/user/docs") + "}"},
+			[]string{testPath("snap2/user/docs")},
+			true,
+		},
+		{
+			"snap of a fallback dir",
+			[]string{"{snap:" + testPath("etc") + "}"},
+			[]string{testPath("etc")},
+			true,
+		},
+		{
+			"orig",
+			[]string{"--tag={orig:" + testPath("home/user") + "}"},
+			[]string{"--tag=" + testPath("home/user")},
+			true,
+		},
+		{
+			"several in one arg",
+			[]string{"{orig:" + testPath("etc") + "}={snap:" + testPath("home") + "}"},
+			[]string{testPath("etc") + "=" + testPath("snap/home")},
+			true,
+		},
+		{
+			"all",
+			[]string{"restic", "backup", "{all}", "--verbose"},
+			[]string{"restic", "backup", testPath("snap/home"), testPath("snap2/user"), testPath("etc"), "--verbose"},
+			true,
+		},
+		{
+			"escaped all",
+			[]string{"echo", "{{all}"},
+			[]string{"echo", "{all}"},
+			false,
+		},
+		{
+			"escaped snap",
+			[]string{"echo", "a{{snap:" + testPath("home") + "}b"},
+			[]string{"echo", "a{snap:" + testPath("home") + "}b"},
+			false,
+		},
+		{
+			"escaped and not escaped",
+			[]string{"{{orig:x}={snap:" + testPath("home") + "}"},
+			[]string{"{orig:x}=" + testPath("snap/home")},
+			true,
+		},
+		{
+			"other braces are kept",
+			[]string{"find", "-exec", "{}", "{{.Name}}", "{other:x}"},
+			[]string{"find", "-exec", "{}", "{{.Name}}", "{other:x}"},
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found, err := expandPlaceholders(tt.args, mappings)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+			if found != tt.found {
+				t.Errorf("found = %v, want %v", found, tt.found)
+			}
+		})
+	}
+}
+
+func TestExpandPlaceholdersErrors(t *testing.T) {
+	mappings := []*snapshotMapping{
+		{Dir: testPath("home"), Path: testPath("snap/home"), Snapshot: true},
+	}
+
+	tests := []struct {
+		name string
+		args []string
+		err  string
+	}{
+		{"all inside an arg", []string{"--dirs={all}"}, "must be used as a separated argument"},
+		{"dir not backed up", []string{"{snap:" + testPath("etc") + "}"}, "is not inside one of the backed up directories"},
+		{"dir with the same prefix", []string{"{orig:" + testPath("homework") + "}"}, "is not inside one of the backed up directories"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := expandPlaceholders(tt.args, mappings)
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("got error %v, want %q", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestCreateSnapshotEnv(t *testing.T) {
+	mappings := []*snapshotMapping{
+		{Dir: "/home", Path: "/snap/home", Snapshot: true},
+		{Dir: "/etc", Path: "/etc", Snapshot: false},
+	}
+
+	got, err := createSnapshotEnv(mappings)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		`FS_SNAPSHOT_MAP=[{"dir":"/home","path":"/snap/home","snapshot":true},{"dir":"/etc","path":"/etc","snapshot":false}]`,
+		"FS_SNAPSHOT_COUNT=2",
+		"FS_SNAPSHOT_1=/snap/home",
+		"FS_SNAPSHOT_1_ORIG=/home",
+		"FS_SNAPSHOT_1_STATUS=snapshot",
+		"FS_SNAPSHOT_2=/etc",
+		"FS_SNAPSHOT_2_ORIG=/etc",
+		"FS_SNAPSHOT_2_STATUS=fallback",
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/alexeyco/simpletable"
+
+	"github.com/pescuma/go-fs-snapshot/lib/fs_snapshot"
+)
+
+const (
+	reportText = "text"
+	reportJson = "json"
+)
+
+// directoryReport is the json output of the report of a dir.
+type directoryReport struct {
+	Dir            string    `json:"dir"`
+	MountPoint     string    `json:"mountPoint,omitempty"`
+	Status         string    `json:"status"`
+	Path           string    `json:"path,omitempty"`
+	SnapshotID     string    `json:"snapshotId,omitempty"`
+	Provider       string    `json:"provider,omitempty"`
+	FallbackReason string    `json:"fallbackReason,omitempty"`
+	Error          string    `json:"error,omitempty"`
+	StartTime      time.Time `json:"startTime"`
+	DurationInMs   int64     `json:"durationInMs"`
+}
+
+func printReport(ctx *context, report *fs_snapshot.BackupReport, format string) error {
+	if format == reportJson {
+		return printReportJson(report)
+	}
+
+	if len(report.Directories) == 0 {
+		return nil
+	}
+
+	table := simpletable.New()
+	table.SetStyle(simpletable.StyleCompactLite)
+
+	table.Header.Cells = append(table.Header.Cells, []*simpletable.Cell{
+		{Text: "Dir"},
+		{Text: "Status"},
+		{Text: "Mount point"},
+		{Text: "Provider"},
+		{Text: "Snapshot ID"},
+		{Text: "Path"},
+		{Text: "Time"},
+	}...)
+
+	var reasons []string
+
+	for _, d := range report.Directories {
+		snapshotID := ""
+		if d.Snapshot != nil {
+			snapshotID = ctx.snapshoter.SimplifyID(d.Snapshot.ID)
+		}
+
+		table.Body.Cells = append(table.Body.Cells, []*simpletable.Cell{
+			{Text: d.Directory},
+			{Text: d.Status.String()},
+			{Text: d.MountPoint},
+			{Text: d.ProviderID},
+			{Text: snapshotID},
+			{Text: d.Path},
+			{Text: d.Duration.Round(time.Millisecond).String(), Align: simpletable.AlignRight},
+		})
+
+		if d.FallbackReason != "" {
+			reasons = append(reasons, fmt.Sprintf("%v: %v", d.Directory, d.FallbackReason))
+		}
+	}
+
+	ctx.console.Print(table.String())
+
+	if len(reasons) > 0 {
+		ctx.console.Print("")
+		for _, r := range reasons {
+			ctx.console.Print(r)
+		}
+	}
+
+	return nil
+}
+
+func printReportJson(report *fs_snapshot.BackupReport) error {
+	result := make([]*directoryReport, 0, len(report.Directories))
+
+	for _, d := range report.Directories {
+		r := &directoryReport{
+			Dir:            d.Directory,
+			MountPoint:     d.MountPoint,
+			Status:         d.Status.String(),
+			Path:           d.Path,
+			Provider:       d.ProviderID,
+			FallbackReason: d.FallbackReason,
+			StartTime:      d.StartTime,
+			DurationInMs:   d.Duration.Milliseconds(),
+		}
+
+		if d.Snapshot != nil {
+			r.SnapshotID = d.Snapshot.ID
+		}
+		if d.Err != nil {
+			r.Error = d.Err.Error()
+		}
+
+		result = append(result, r)
+	}
+
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(os.Stdout, string(data))
+
+	return nil
+}
@@ -0,0 +1,238 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type runCmd struct {
+	Job    string `arg:"" help:"Name of the job to run."`
+	Config string `short:"c" help:"Config file with the jobs (.yaml, .yml or .toml). Default is fs_snapshot.yaml, fs_snapshot.yml or fs_snapshot.toml in the current folder or in the user config folder." type:"path"`
+
+	job *jobConfig
+}
+
+// AfterApply loads the config before the command runs, because it has the server args and the output format.
+func (c *runCmd) AfterApply() error {
+	file := c.Config
+	if file == "" {
+		var err error
+		file, err = findJobsConfigFile()
+		if err != nil {
+			return err
+		}
+	}
+
+	cfg, err := loadJobsConfig(file)
+	if err != nil {
+		return err
+	}
+
+	c.job = cfg.job(c.Job)
+	if c.job == nil {
+		return errors.Errorf("%v: unknown job %v, available jobs are: %v", file, c.Job, strings.Join(cfg.jobNames(), ", "))
+	}
+
+	err = c.job.checkDirs()
+	if err != nil {
+		return errors.Wrapf(err, "%v: job %v", file, c.Job)
+	}
+
+	return nil
+}
+
+func (c *runCmd) GetServerArgs() *serverArgs {
+	return c.job.serverArgs()
+}
+
+func (c *runCmd) WritesToStdout() bool {
+	return c.job.Output == outputJson
+}
+
+// jobResult is the output of the run command when using json output.
+type jobResult struct {
+	Job       string             `json:"job"`
+	Status    string             `json:"status"`
+	Error     string             `json:"error,omitempty"`
+	StartTime time.Time          `json:"startTime"`
+	EndTime   time.Time          `json:"endTime"`
+	Dirs      []*snapshotMapping `json:"dirs"`
+}
+
+func (c *runCmd) Run(ctx *context) error {
+	start := time.Now()
+
+	backup, err := c.createBackupCmd(ctx)
+	if err == nil {
+		err = c.runHooks(ctx, "pre", c.job.Pre, nil)
+	}
+	if err == nil {
+		err = backup.Run(ctx)
+	}
+
+	status := "success"
+	if err != nil {
+		status = "failure"
+	}
+
+	// Post hooks are always executed, and receive the result of the job
+	postErr := c.runHooks(ctx, "post", c.job.Post, []string{"FS_SNAPSHOT_JOB_STATUS=" + status})
+	if err == nil && postErr != nil {
+		err = postErr
+		status = "failure"
+	}
+
+	if c.job.Output == outputJson {
+		result := &jobResult{
+			Job:       c.Job,
+			Status:    status,
+			StartTime: start,
+			EndTime:   time.Now(),
+			Dirs:      backup.mappings,
+		}
+		if err != nil {
+			result.Error = err.Error()
+		}
+		if result.Dirs == nil {
+			result.Dirs = []*snapshotMapping{}
+		}
+
+		data, jsonErr := json.MarshalIndent(result, "", "  ")
+		if jsonErr != nil {
+			return jsonErr
+		}
+
+		fmt.Fprintln(os.Stdout, string(data))
+
+	} else if err == nil {
+		ctx.console.Printf("Job %v finished successfully", c.Job)
+	}
+
+	if err != nil {
+		return errors.Wrapf(err, "Job %v failed", c.Job)
+	}
+
+	return nil
+}
+
+func (c *runCmd) createBackupCmd(ctx *context) (*backupCmd, error) {
+	providerID, err := c.selectProvider(ctx)
+	if err != nil {
+		return &backupCmd{}, err
+	}
+
+	result := &backupCmd{
+		Dirs:          c.job.Dirs,
+		ProviderID:    providerID,
+		Simple:        c.job.Simple != nil && *c.job.Simple,
+		Exec:          c.job.Exec,
+		NoShell:       c.job.NoShell != nil && *c.job.NoShell,
+		PrivateMounts: c.job.PrivateMounts != nil && *c.job.PrivateMounts,
+		FailurePolicy: c.job.FailurePolicy,
+		Report:        reportText,
+		env:           []string{"FS_SNAPSHOT_JOB=" + c.Job},
+	}
+
+	if c.job.Timeout != nil {
+		result.Timeout = time.Duration(*c.job.Timeout)
+	}
+	if c.job.MinFreeSpace != nil {
+		result.MinFreeSpace = *c.job.MinFreeSpace
+	}
+	if c.job.MinFreePercent != nil {
+		result.MinFreePercent = *c.job.MinFreePercent
+	}
+	if c.job.MaxSnapshotsSize != nil {
+		result.MaxSnapshotsSize = *c.job.MaxSnapshotsSize
+	}
+	result.FreeSpaceAction = c.job.FreeSpaceAction
+	result.Protect = c.job.ProtectedSnapshots
+	result.Monitor = c.job.Monitor != nil && *c.job.Monitor
+	if c.job.AutoExtend != nil {
+		result.AutoExtend = *c.job.AutoExtend
+	}
+	if c.job.RetryAttempts != nil {
+		result.RetryAttempts = *c.job.RetryAttempts
+	}
+	if c.job.RetryBackoff != nil {
+		result.RetryBackoff = time.Duration(*c.job.RetryBackoff)
+	}
+	result.RetryAllErrors = c.job.RetryAllErrors != nil && *c.job.RetryAllErrors
+
+	return result, nil
+}
+
+// selectProvider returns the first available provider of the job, or "" to use the default one.
+func (c *runCmd) selectProvider(ctx *context) (string, error) {
+	if len(c.job.Providers) == 0 {
+		return "", nil
+	}
+
+	for _, id := range c.job.Providers {
+		ps, err := ctx.snapshoter.ListProviders(id)
+		if err != nil {
+			return "", err
+		}
+
+		if len(ps) > 0 {
+			ctx.console.Printf("Using provider %v", ps[0].Name)
+			return ps[0].ID, nil
+		}
+
+		ctx.console.Printf("Provider %v is not available", id)
+	}
+
+	return "", errors.Errorf("none of the providers is available: %v", strings.Join(c.job.Providers, ", "))
+}
+
+func (c *runCmd) runHooks(ctx *context, name string, hooks []string, env []string) error {
+	for _, hook := range hooks {
+		cmd, err := c.createHookCommand(ctx, hook)
+		if err != nil {
+			return errors.Wrapf(err, "Error in %v hook", name)
+		}
+
+		cmd.Env = append(cmd.Env, "FS_SNAPSHOT_JOB="+c.Job)
+		cmd.Env = append(cmd.Env, env...)
+
+		ctx.console.Printf("Executing %v hook: %v", name, hook)
+
+		err = cmd.Run()
+		if err != nil {
+			return errors.Wrapf(err, "Error executing %v hook", name)
+		}
+	}
+
+	return nil
+}
+
+// createHookCommand passes the whole hook line to the shell, so it can use pipes, redirects, etc.
+func (c *runCmd) createHookCommand(ctx *context, hook string) (*exec.Cmd, error) {
+	if c.job.NoShell != nil && *c.job.NoShell {
+		return createCommand(hook, true, ctx.console.out)
+	}
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command(os.Getenv("COMSPEC"), "/c", hook)
+	} else {
+		cmd = exec.Command("sh", "-e", "-c", hook)
+	}
+	if cmd.Err != nil {
+		return nil, cmd.Err
+	}
+
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = ctx.console.out
+	cmd.Stderr = os.Stderr
+	cmd.Env = os.Environ()
+
+	return cmd, nil
+}
@@ -1,6 +1,8 @@
 package main
 
 type serverArgs struct {
-	Server               string `help:"Server to connect to, in the format ip:port"`
+	Server               string `help:"Server to connect to, in the format ip:port or unix:/path/to/socket"`
 	ServerOnlyAsFallback bool   `help:"Use server only as fallback. This only applies if --server is used."`
+	ServerAuth           string `enum:"default,none,token,tls" default:"default" help:"How to authenticate with the server (default, none, token or tls). Default uses TLS if the user was enabled with certificates."`
+	ServerCredentials    string `help:"Folder with the server credentials. Default is the one used by 'enable'." type:"path"`
 }
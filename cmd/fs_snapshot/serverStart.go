@@ -1,27 +1,59 @@
 package main
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/pescuma/go-fs-snapshot/lib/fs_snapshot"
 )
 
 type serverStartCmd struct {
-	Bind           string        `help:"Address to bind, in the format ip:port. Both can be empty, but the : must be there."`
-	InactivityTime time.Duration `help:"After how long without a request should the server shut down. Default is never."`
-	Force          bool          `short:"f" help:"Start the server even if it's not supported in this OS. For tests.'"`
+	Bind                []string      `help:"Address to bind, in the format ip:port or unix:/path/to/socket. For ip:port both can be empty, but the : must be there. Can be used more than once."`
+	InactivityTime      time.Duration `help:"After how long without a request should the server shut down. Default is never."`
+	LeaseTime           time.Duration `help:"After how long without hearing from a client should its backup be closed and its snapshots deleted. Default is 1 minute."`
+	HTTP                string        `help:"Address to bind an HTTP/JSON API, in the format ip:port. Default is to not start it."`
+	Auth                string        `enum:"default,none,token,tls" default:"default" help:"How to authenticate clients (default, none, token or tls). Default uses TLS if 'enable' created the certificates."`
+	CredentialsDir      string        `help:"Folder with the server credentials. Default is the one used by 'enable'." type:"path"`
+	Policy              string        `help:"JSON file with the policy that says what each user can do. Default is to allow everything to authenticated users." type:"existingfile"`
+	HealthCheckInterval time.Duration `help:"How often to check if the server can still create snapshots, for the health service. Default is 1 minute."`
+	Reflection          bool          `help:"Enable gRPC reflection, to allow debugging with generic gRPC tools."`
+	AuditLog            string        `help:"File to append a JSON line for each request received." type:"path"`
+	AuditSyslog         bool          `help:"Also send the audit log to syslog. Not available in Windows."`
+	Force               bool          `short:"f" help:"Start the server even if it's not supported in this OS. For tests.'"`
 }
 
 func (c *serverStartCmd) Run(ctx *context) error {
 	var err error
 
-	var ip string
-	var port int
-	if c.Bind != "" {
-		ip, port, err = parseAddr(c.Bind)
+	var listen []string
+	for _, b := range c.Bind {
+		if strings.HasPrefix(b, "unix:") {
+			listen = append(listen, b)
+			continue
+		}
+
+		ip, port, err := parseAddr(b)
 		if err != nil {
 			return err
 		}
+
+		if ip == "" {
+			ip = fs_snapshot.DefaultIP
+		}
+		if ip == "0.0.0.0" {
+			ip = ""
+		}
+		if port == 0 {
+			port = fs_snapshot.DefaultPort
+		}
+
+		listen = append(listen, fmt.Sprintf("%v:%v", ip, port))
+	}
+
+	auth, err := fs_snapshot.ParseAuthMode(c.Auth)
+	if err != nil {
+		return err
 	}
 
 	s, err := fs_snapshot.NewSnapshoter(&fs_snapshot.SnapshoterConfig{
@@ -38,10 +70,19 @@ func (c *serverStartCmd) Run(ctx *context) error {
 	}
 
 	err = fs_snapshot.StartServer(s, &fs_snapshot.ServerConfig{
-		InactivityTime: c.InactivityTime,
-		InfoCallback:   ctx.console.NewInfoMessageCallback(),
-		IP:             ip,
-		Port:           port,
+		InactivityTime:      c.InactivityTime,
+		LeaseTime:           c.LeaseTime,
+		InfoCallback:        ctx.console.NewInfoMessageCallback(),
+		Listen:              listen,
+		HTTPAddress:         c.HTTP,
+		Version:             version,
+		HealthCheckInterval: c.HealthCheckInterval,
+		Reflection:          c.Reflection,
+		Auth:                auth,
+		CredentialsDir:      c.CredentialsDir,
+		PolicyFile:          c.Policy,
+		AuditLog:            c.AuditLog,
+		AuditSyslog:         c.AuditSyslog,
 	})
 	if err != nil {
 		return err
@@ -1,12 +1,28 @@
 package main
 
 import (
+	"os"
 	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
+
+	"github.com/pescuma/go-fs-snapshot/lib/fs_snapshot"
 )
 
+// byteSize accepts sizes in the fs_snapshot.ParseSize format, like 500M or 10G.
+type byteSize int64
+
+func (s *byteSize) UnmarshalText(text []byte) error {
+	r, err := fs_snapshot.ParseSize(string(text))
+	if err != nil {
+		return err
+	}
+
+	*s = byteSize(r)
+	return nil
+}
+
 func parseAddr(addr string) (string, int, error) {
 	ip := ""
 	port := 0
@@ -34,3 +50,17 @@ func parseAddr(addr string) (string, int, error) {
 
 	return ip, port, nil
 }
+
+// checkSnapshotPath returns an error if the path is not something that can be snapshoted: a dir or a regular file.
+func checkSnapshotPath(path string) error {
+	s, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	if !s.IsDir() && !s.Mode().IsRegular() {
+		return errors.Errorf("%v is not a directory or a regular file", path)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+
+	"github.com/pescuma/go-fs-snapshot/lib/fs_snapshot"
+)
+
+type verifyCmd struct {
+	Target        string `arg:"" name:"snapshot-id|dir" help:"The ID (simplified or full) of a snapshot, or a directory (like a restored tree) to check. Directories are read directly, without a snapshot."`
+	Manifest      string `required:"" type:"existingfile" help:"Manifest created by the manifest command."`
+	IgnoreModTime bool   `help:"Do not compare modification times."`
+	Concurrency   int    `help:"Number of files to hash at the same time. Default is the number of CPUs."`
+
+	ServerArgs serverArgs `embed:""`
+}
+
+func (c *verifyCmd) Run(ctx *context) error {
+	manifest, err := c.readManifest()
+	if err != nil {
+		return err
+	}
+
+	var files fs_snapshot.FS
+
+	if s, err := os.Stat(c.Target); err == nil && s.IsDir() {
+		files = fs_snapshot.DirFS(c.Target)
+
+	} else {
+		snapshot, err := findOneSnapshot(ctx, c.Target)
+		if err != nil {
+			return err
+		}
+		if snapshot == nil {
+			return nil
+		}
+
+		files = snapshot.FS()
+	}
+
+	mismatches, err := fs_snapshot.VerifyManifest(files, manifest, &fs_snapshot.ManifestConfig{
+		Concurrency:   c.Concurrency,
+		IgnoreModTime: c.IgnoreModTime,
+		InfoCallback:  ctx.console.NewInfoMessageCallback(),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, m := range mismatches {
+		ctx.console.Print(m.String())
+	}
+
+	if len(mismatches) > 0 {
+		return errors.Errorf("%v differences found", len(mismatches))
+	}
+
+	ctx.console.Printf("%v entries verified", len(manifest.Entries))
+
+	return nil
+}
+
+func (c *verifyCmd) readManifest() (*fs_snapshot.Manifest, error) {
+	file, err := os.Open(c.Manifest)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error reading manifest")
+	}
+	defer file.Close()
+
+	return fs_snapshot.ReadManifest(file)
+}
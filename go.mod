@@ -3,17 +3,20 @@ module github.com/pescuma/go-fs-snapshot
 go 1.17
 
 require (
+	github.com/BurntSushi/toml v1.3.2
 	github.com/Masterminds/semver/v3 v3.1.1
 	github.com/alecthomas/kong v0.6.1
 	github.com/alexeyco/simpletable v1.0.0
 	github.com/fourcorelabs/wintoken v1.0.0
 	github.com/go-ole/go-ole v1.2.6
+	github.com/klauspost/compress v1.15.15
 	github.com/mattn/go-shellwords v1.0.12
 	github.com/pkg/errors v0.9.1
 	github.com/winlabs/gowin32 v0.0.0-20221003142512-0d265587d3c9
 	golang.org/x/sys v0.0.0-20220811171246-fbc7d0a398ab
 	google.golang.org/grpc v1.49.0
 	google.golang.org/protobuf v1.28.1
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -0,0 +1,4705 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.5
+// source: fs_snapshot.proto
+
+// Public API of the fs_snapshot server.
+// Changes to this version must be backwards compatible: only add fields, messages and RPCs. Clients should
+// call GetServerInfo to find out what the server supports.
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type FreeSpaceAction int32
+
+const (
+	FreeSpaceAction_Refuse       FreeSpaceAction = 0
+	FreeSpaceAction_DeleteOldest FreeSpaceAction = 1
+)
+
+// Enum value maps for FreeSpaceAction.
+var (
+	FreeSpaceAction_name = map[int32]string{
+		0: "Refuse",
+		1: "DeleteOldest",
+	}
+	FreeSpaceAction_value = map[string]int32{
+		"Refuse":       0,
+		"DeleteOldest": 1,
+	}
+)
+
+func (x FreeSpaceAction) Enum() *FreeSpaceAction {
+	p := new(FreeSpaceAction)
+	*p = x
+	return p
+}
+
+func (x FreeSpaceAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FreeSpaceAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_fs_snapshot_proto_enumTypes[0].Descriptor()
+}
+
+func (FreeSpaceAction) Type() protoreflect.EnumType {
+	return &file_fs_snapshot_proto_enumTypes[0]
+}
+
+func (x FreeSpaceAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FreeSpaceAction.Descriptor instead.
+func (FreeSpaceAction) EnumDescriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{0}
+}
+
+type FailurePolicy int32
+
+const (
+	FailurePolicy_Fallback FailurePolicy = 0
+	FailurePolicy_Skip     FailurePolicy = 1
+	FailurePolicy_Abort    FailurePolicy = 2
+)
+
+// Enum value maps for FailurePolicy.
+var (
+	FailurePolicy_name = map[int32]string{
+		0: "Fallback",
+		1: "Skip",
+		2: "Abort",
+	}
+	FailurePolicy_value = map[string]int32{
+		"Fallback": 0,
+		"Skip":     1,
+		"Abort":    2,
+	}
+)
+
+func (x FailurePolicy) Enum() *FailurePolicy {
+	p := new(FailurePolicy)
+	*p = x
+	return p
+}
+
+func (x FailurePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FailurePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_fs_snapshot_proto_enumTypes[1].Descriptor()
+}
+
+func (FailurePolicy) Type() protoreflect.EnumType {
+	return &file_fs_snapshot_proto_enumTypes[1]
+}
+
+func (x FailurePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FailurePolicy.Descriptor instead.
+func (FailurePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{1}
+}
+
+type DirectoryStatus int32
+
+const (
+	DirectoryStatus_DirectorySnapshoted DirectoryStatus = 0
+	DirectoryStatus_DirectoryFallback   DirectoryStatus = 1
+	DirectoryStatus_DirectorySkipped    DirectoryStatus = 2
+	DirectoryStatus_DirectoryAborted    DirectoryStatus = 3
+)
+
+// Enum value maps for DirectoryStatus.
+var (
+	DirectoryStatus_name = map[int32]string{
+		0: "DirectorySnapshoted",
+		1: "DirectoryFallback",
+		2: "DirectorySkipped",
+		3: "DirectoryAborted",
+	}
+	DirectoryStatus_value = map[string]int32{
+		"DirectorySnapshoted": 0,
+		"DirectoryFallback":   1,
+		"DirectorySkipped":    2,
+		"DirectoryAborted":    3,
+	}
+)
+
+func (x DirectoryStatus) Enum() *DirectoryStatus {
+	p := new(DirectoryStatus)
+	*p = x
+	return p
+}
+
+func (x DirectoryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DirectoryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_fs_snapshot_proto_enumTypes[2].Descriptor()
+}
+
+func (DirectoryStatus) Type() protoreflect.EnumType {
+	return &file_fs_snapshot_proto_enumTypes[2]
+}
+
+func (x DirectoryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DirectoryStatus.Descriptor instead.
+func (DirectoryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{2}
+}
+
+type MessageLevel int32
+
+const (
+	MessageLevel_OutputLevel  MessageLevel = 0
+	MessageLevel_InfoLevel    MessageLevel = 1
+	MessageLevel_DetailsLevel MessageLevel = 2
+	MessageLevel_TraceLevel   MessageLevel = 3
+)
+
+// Enum value maps for MessageLevel.
+var (
+	MessageLevel_name = map[int32]string{
+		0: "OutputLevel",
+		1: "InfoLevel",
+		2: "DetailsLevel",
+		3: "TraceLevel",
+	}
+	MessageLevel_value = map[string]int32{
+		"OutputLevel":  0,
+		"InfoLevel":    1,
+		"DetailsLevel": 2,
+		"TraceLevel":   3,
+	}
+)
+
+func (x MessageLevel) Enum() *MessageLevel {
+	p := new(MessageLevel)
+	*p = x
+	return p
+}
+
+func (x MessageLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MessageLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_fs_snapshot_proto_enumTypes[3].Descriptor()
+}
+
+func (MessageLevel) Type() protoreflect.EnumType {
+	return &file_fs_snapshot_proto_enumTypes[3]
+}
+
+func (x MessageLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MessageLevel.Descriptor instead.
+func (MessageLevel) EnumDescriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{3}
+}
+
+type GetServerInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetServerInfoRequest) Reset() {
+	*x = GetServerInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServerInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerInfoRequest) ProtoMessage() {}
+
+func (x *GetServerInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetServerInfoRequest) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{0}
+}
+
+type GetServerInfoReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Version of the fs_snapshot server.
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Version of this API. Changes only when there are incompatible changes.
+	ApiVersion int32 `protobuf:"varint,2,opt,name=apiVersion,proto3" json:"apiVersion,omitempty"`
+	// OS and architecture of the server, in Go format (for example: linux, amd64).
+	Os        string      `protobuf:"bytes,3,opt,name=os,proto3" json:"os,omitempty"`
+	Arch      string      `protobuf:"bytes,4,opt,name=arch,proto3" json:"arch,omitempty"`
+	Providers []*Provider `protobuf:"bytes,5,rep,name=providers,proto3" json:"providers,omitempty"`
+	// The case sensitivity depends on the file system, so clients detect it for each mount point.
+	// Still sent with the default of the server OS, for older clients.
+	//
+	// Deprecated: Do not use.
+	CaseSensitive bool `protobuf:"varint,6,opt,name=caseSensitive,proto3" json:"caseSensitive,omitempty"`
+	// Optional features the server supports. See the Feature* constants in the fs_snapshot package.
+	Features []string `protobuf:"bytes,7,rep,name=features,proto3" json:"features,omitempty"`
+}
+
+func (x *GetServerInfoReply) Reset() {
+	*x = GetServerInfoReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServerInfoReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerInfoReply) ProtoMessage() {}
+
+func (x *GetServerInfoReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerInfoReply.ProtoReflect.Descriptor instead.
+func (*GetServerInfoReply) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetServerInfoReply) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetServerInfoReply) GetApiVersion() int32 {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return 0
+}
+
+func (x *GetServerInfoReply) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *GetServerInfoReply) GetArch() string {
+	if x != nil {
+		return x.Arch
+	}
+	return ""
+}
+
+func (x *GetServerInfoReply) GetProviders() []*Provider {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+// Deprecated: Do not use.
+func (x *GetServerInfoReply) GetCaseSensitive() bool {
+	if x != nil {
+		return x.CaseSensitive
+	}
+	return false
+}
+
+func (x *GetServerInfoReply) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+type CanCreateSnapshotsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CanCreateSnapshotsRequest) Reset() {
+	*x = CanCreateSnapshotsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CanCreateSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanCreateSnapshotsRequest) ProtoMessage() {}
+
+func (x *CanCreateSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanCreateSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*CanCreateSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{2}
+}
+
+type CanCreateSnapshotsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Can bool `protobuf:"varint,1,opt,name=can,proto3" json:"can,omitempty"`
+}
+
+func (x *CanCreateSnapshotsReply) Reset() {
+	*x = CanCreateSnapshotsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CanCreateSnapshotsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanCreateSnapshotsReply) ProtoMessage() {}
+
+func (x *CanCreateSnapshotsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanCreateSnapshotsReply.ProtoReflect.Descriptor instead.
+func (*CanCreateSnapshotsReply) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CanCreateSnapshotsReply) GetCan() bool {
+	if x != nil {
+		return x.Can
+	}
+	return false
+}
+
+type ListProvidersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FilterId string `protobuf:"bytes,1,opt,name=filterId,proto3" json:"filterId,omitempty"`
+}
+
+func (x *ListProvidersRequest) Reset() {
+	*x = ListProvidersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProvidersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProvidersRequest) ProtoMessage() {}
+
+func (x *ListProvidersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProvidersRequest.ProtoReflect.Descriptor instead.
+func (*ListProvidersRequest) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListProvidersRequest) GetFilterId() string {
+	if x != nil {
+		return x.FilterId
+	}
+	return ""
+}
+
+type ListProvidersReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Providers []*Provider `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+}
+
+func (x *ListProvidersReply) Reset() {
+	*x = ListProvidersReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProvidersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProvidersReply) ProtoMessage() {}
+
+func (x *ListProvidersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProvidersReply.ProtoReflect.Descriptor instead.
+func (*ListProvidersReply) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListProvidersReply) GetProviders() []*Provider {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+type ListSetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FilterId string `protobuf:"bytes,1,opt,name=filterId,proto3" json:"filterId,omitempty"`
+}
+
+func (x *ListSetsRequest) Reset() {
+	*x = ListSetsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSetsRequest) ProtoMessage() {}
+
+func (x *ListSetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSetsRequest.ProtoReflect.Descriptor instead.
+func (*ListSetsRequest) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListSetsRequest) GetFilterId() string {
+	if x != nil {
+		return x.FilterId
+	}
+	return ""
+}
+
+type ListSetsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sets []*SnapshotSet `protobuf:"bytes,1,rep,name=sets,proto3" json:"sets,omitempty"`
+}
+
+func (x *ListSetsReply) Reset() {
+	*x = ListSetsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSetsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSetsReply) ProtoMessage() {}
+
+func (x *ListSetsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSetsReply.ProtoReflect.Descriptor instead.
+func (*ListSetsReply) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListSetsReply) GetSets() []*SnapshotSet {
+	if x != nil {
+		return x.Sets
+	}
+	return nil
+}
+
+type ListSnapshotsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FilterId string `protobuf:"bytes,1,opt,name=filterId,proto3" json:"filterId,omitempty"`
+}
+
+func (x *ListSnapshotsRequest) Reset() {
+	*x = ListSnapshotsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSnapshotsRequest) ProtoMessage() {}
+
+func (x *ListSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*ListSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListSnapshotsRequest) GetFilterId() string {
+	if x != nil {
+		return x.FilterId
+	}
+	return ""
+}
+
+type ListSnapshotsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Snapshots []*Snapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+}
+
+func (x *ListSnapshotsReply) Reset() {
+	*x = ListSnapshotsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSnapshotsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSnapshotsReply) ProtoMessage() {}
+
+func (x *ListSnapshotsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSnapshotsReply.ProtoReflect.Descriptor instead.
+func (*ListSnapshotsReply) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListSnapshotsReply) GetSnapshots() []*Snapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+type SimplifyIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *SimplifyIdRequest) Reset() {
+	*x = SimplifyIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimplifyIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimplifyIdRequest) ProtoMessage() {}
+
+func (x *SimplifyIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimplifyIdRequest.ProtoReflect.Descriptor instead.
+func (*SimplifyIdRequest) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SimplifyIdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type SimplifyIdReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SimpleId string `protobuf:"bytes,1,opt,name=simpleId,proto3" json:"simpleId,omitempty"`
+}
+
+func (x *SimplifyIdReply) Reset() {
+	*x = SimplifyIdReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimplifyIdReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimplifyIdReply) ProtoMessage() {}
+
+func (x *SimplifyIdReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimplifyIdReply.ProtoReflect.Descriptor instead.
+func (*SimplifyIdReply) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SimplifyIdReply) GetSimpleId() string {
+	if x != nil {
+		return x.SimpleId
+	}
+	return ""
+}
+
+type DeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Force bool   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+}
+
+func (x *DeleteRequest) Reset() {
+	*x = DeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRequest) ProtoMessage() {}
+
+func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type DeleteReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deleted bool `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (x *DeleteReply) Reset() {
+	*x = DeleteReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReply) ProtoMessage() {}
+
+func (x *DeleteReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReply.ProtoReflect.Descriptor instead.
+func (*DeleteReply) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteReply) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+type ListMountPointsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Volume string `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+}
+
+func (x *ListMountPointsRequest) Reset() {
+	*x = ListMountPointsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMountPointsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMountPointsRequest) ProtoMessage() {}
+
+func (x *ListMountPointsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMountPointsRequest.ProtoReflect.Descriptor instead.
+func (*ListMountPointsRequest) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListMountPointsRequest) GetVolume() string {
+	if x != nil {
+		return x.Volume
+	}
+	return ""
+}
+
+type ListMountPointsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MountPoints []string `protobuf:"bytes,1,rep,name=mountPoints,proto3" json:"mountPoints,omitempty"`
+}
+
+func (x *ListMountPointsReply) Reset() {
+	*x = ListMountPointsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMountPointsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMountPointsReply) ProtoMessage() {}
+
+func (x *ListMountPointsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMountPointsReply.ProtoReflect.Descriptor instead.
+func (*ListMountPointsReply) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListMountPointsReply) GetMountPoints() []string {
+	if x != nil {
+		return x.MountPoints
+	}
+	return nil
+}
+
+type GetVolumeUsageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Volume string `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+}
+
+func (x *GetVolumeUsageRequest) Reset() {
+	*x = GetVolumeUsageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVolumeUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolumeUsageRequest) ProtoMessage() {}
+
+func (x *GetVolumeUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolumeUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetVolumeUsageRequest) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetVolumeUsageRequest) GetVolume() string {
+	if x != nil {
+		return x.Volume
+	}
+	return ""
+}
+
+type GetVolumeUsageReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Volume           string `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	TotalSize        int64  `protobuf:"varint,2,opt,name=totalSize,proto3" json:"totalSize,omitempty"`
+	FreeSpace        int64  `protobuf:"varint,3,opt,name=freeSpace,proto3" json:"freeSpace,omitempty"`
+	SnapshotsSize    int64  `protobuf:"varint,4,opt,name=snapshotsSize,proto3" json:"snapshotsSize,omitempty"`       // -1 if unknown
+	SnapshotsMaxSize int64  `protobuf:"varint,5,opt,name=snapshotsMaxSize,proto3" json:"snapshotsMaxSize,omitempty"` // -1 if unlimited or unknown
+}
+
+func (x *GetVolumeUsageReply) Reset() {
+	*x = GetVolumeUsageReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVolumeUsageReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolumeUsageReply) ProtoMessage() {}
+
+func (x *GetVolumeUsageReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolumeUsageReply.ProtoReflect.Descriptor instead.
+func (*GetVolumeUsageReply) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetVolumeUsageReply) GetVolume() string {
+	if x != nil {
+		return x.Volume
+	}
+	return ""
+}
+
+func (x *GetVolumeUsageReply) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+func (x *GetVolumeUsageReply) GetFreeSpace() int64 {
+	if x != nil {
+		return x.FreeSpace
+	}
+	return 0
+}
+
+func (x *GetVolumeUsageReply) GetSnapshotsSize() int64 {
+	if x != nil {
+		return x.SnapshotsSize
+	}
+	return 0
+}
+
+func (x *GetVolumeUsageReply) GetSnapshotsMaxSize() int64 {
+	if x != nil {
+		return x.SnapshotsMaxSize
+	}
+	return 0
+}
+
+type StartBackupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProviderId    string           `protobuf:"bytes,1,opt,name=providerId,proto3" json:"providerId,omitempty"`
+	TimeoutInSec  int32            `protobuf:"varint,2,opt,name=timeoutInSec,proto3" json:"timeoutInSec,omitempty"`
+	Simple        bool             `protobuf:"varint,3,opt,name=simple,proto3" json:"simple,omitempty"`
+	FreeSpace     *FreeSpaceConfig `protobuf:"bytes,4,opt,name=freeSpace,proto3" json:"freeSpace,omitempty"`
+	Monitor       *MonitorConfig   `protobuf:"bytes,5,opt,name=monitor,proto3" json:"monitor,omitempty"`
+	Retry         *RetryConfig     `protobuf:"bytes,6,opt,name=retry,proto3" json:"retry,omitempty"`
+	FailurePolicy FailurePolicy    `protobuf:"varint,7,opt,name=failurePolicy,proto3,enum=fs_snapshot.v1.FailurePolicy" json:"failurePolicy,omitempty"`
+}
+
+func (x *StartBackupRequest) Reset() {
+	*x = StartBackupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartBackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartBackupRequest) ProtoMessage() {}
+
+func (x *StartBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartBackupRequest.ProtoReflect.Descriptor instead.
+func (*StartBackupRequest) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *StartBackupRequest) GetProviderId() string {
+	if x != nil {
+		return x.ProviderId
+	}
+	return ""
+}
+
+func (x *StartBackupRequest) GetTimeoutInSec() int32 {
+	if x != nil {
+		return x.TimeoutInSec
+	}
+	return 0
+}
+
+func (x *StartBackupRequest) GetSimple() bool {
+	if x != nil {
+		return x.Simple
+	}
+	return false
+}
+
+func (x *StartBackupRequest) GetFreeSpace() *FreeSpaceConfig {
+	if x != nil {
+		return x.FreeSpace
+	}
+	return nil
+}
+
+func (x *StartBackupRequest) GetMonitor() *MonitorConfig {
+	if x != nil {
+		return x.Monitor
+	}
+	return nil
+}
+
+func (x *StartBackupRequest) GetRetry() *RetryConfig {
+	if x != nil {
+		return x.Retry
+	}
+	return nil
+}
+
+func (x *StartBackupRequest) GetFailurePolicy() FailurePolicy {
+	if x != nil {
+		return x.FailurePolicy
+	}
+	return FailurePolicy_Fallback
+}
+
+type FreeSpaceConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinFreeSpace       int64           `protobuf:"varint,1,opt,name=minFreeSpace,proto3" json:"minFreeSpace,omitempty"`
+	MinFreePercent     float64         `protobuf:"fixed64,2,opt,name=minFreePercent,proto3" json:"minFreePercent,omitempty"`
+	MaxSnapshotsSize   int64           `protobuf:"varint,3,opt,name=maxSnapshotsSize,proto3" json:"maxSnapshotsSize,omitempty"`
+	Action             FreeSpaceAction `protobuf:"varint,4,opt,name=action,proto3,enum=fs_snapshot.v1.FreeSpaceAction" json:"action,omitempty"`
+	ProtectedSnapshots []string        `protobuf:"bytes,5,rep,name=protectedSnapshots,proto3" json:"protectedSnapshots,omitempty"`
+}
+
+func (x *FreeSpaceConfig) Reset() {
+	*x = FreeSpaceConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FreeSpaceConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreeSpaceConfig) ProtoMessage() {}
+
+func (x *FreeSpaceConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreeSpaceConfig.ProtoReflect.Descriptor instead.
+func (*FreeSpaceConfig) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *FreeSpaceConfig) GetMinFreeSpace() int64 {
+	if x != nil {
+		return x.MinFreeSpace
+	}
+	return 0
+}
+
+func (x *FreeSpaceConfig) GetMinFreePercent() float64 {
+	if x != nil {
+		return x.MinFreePercent
+	}
+	return 0
+}
+
+func (x *FreeSpaceConfig) GetMaxSnapshotsSize() int64 {
+	if x != nil {
+		return x.MaxSnapshotsSize
+	}
+	return 0
+}
+
+func (x *FreeSpaceConfig) GetAction() FreeSpaceAction {
+	if x != nil {
+		return x.Action
+	}
+	return FreeSpaceAction_Refuse
+}
+
+func (x *FreeSpaceConfig) GetProtectedSnapshots() []string {
+	if x != nil {
+		return x.ProtectedSnapshots
+	}
+	return nil
+}
+
+type MonitorConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IntervalInSec   int32     `protobuf:"varint,1,opt,name=intervalInSec,proto3" json:"intervalInSec,omitempty"`
+	WarnThresholds  []float64 `protobuf:"fixed64,2,rep,packed,name=warnThresholds,proto3" json:"warnThresholds,omitempty"`
+	AutoExtend      bool      `protobuf:"varint,3,opt,name=autoExtend,proto3" json:"autoExtend,omitempty"`
+	ExtendThreshold float64   `protobuf:"fixed64,4,opt,name=extendThreshold,proto3" json:"extendThreshold,omitempty"`
+	ExtendSize      int64     `protobuf:"varint,5,opt,name=extendSize,proto3" json:"extendSize,omitempty"`
+}
+
+func (x *MonitorConfig) Reset() {
+	*x = MonitorConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MonitorConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonitorConfig) ProtoMessage() {}
+
+func (x *MonitorConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonitorConfig.ProtoReflect.Descriptor instead.
+func (*MonitorConfig) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MonitorConfig) GetIntervalInSec() int32 {
+	if x != nil {
+		return x.IntervalInSec
+	}
+	return 0
+}
+
+func (x *MonitorConfig) GetWarnThresholds() []float64 {
+	if x != nil {
+		return x.WarnThresholds
+	}
+	return nil
+}
+
+func (x *MonitorConfig) GetAutoExtend() bool {
+	if x != nil {
+		return x.AutoExtend
+	}
+	return false
+}
+
+func (x *MonitorConfig) GetExtendThreshold() float64 {
+	if x != nil {
+		return x.ExtendThreshold
+	}
+	return 0
+}
+
+func (x *MonitorConfig) GetExtendSize() int64 {
+	if x != nil {
+		return x.ExtendSize
+	}
+	return 0
+}
+
+type RetryConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attempts       int32 `protobuf:"varint,1,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	BackoffInMs    int64 `protobuf:"varint,2,opt,name=backoffInMs,proto3" json:"backoffInMs,omitempty"`
+	MaxBackoffInMs int64 `protobuf:"varint,3,opt,name=maxBackoffInMs,proto3" json:"maxBackoffInMs,omitempty"`
+	RetryAllErrors bool  `protobuf:"varint,4,opt,name=retryAllErrors,proto3" json:"retryAllErrors,omitempty"`
+}
+
+func (x *RetryConfig) Reset() {
+	*x = RetryConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetryConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryConfig) ProtoMessage() {}
+
+func (x *RetryConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryConfig.ProtoReflect.Descriptor instead.
+func (*RetryConfig) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RetryConfig) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *RetryConfig) GetBackoffInMs() int64 {
+	if x != nil {
+		return x.BackoffInMs
+	}
+	return 0
+}
+
+func (x *RetryConfig) GetMaxBackoffInMs() int64 {
+	if x != nil {
+		return x.MaxBackoffInMs
+	}
+	return 0
+}
+
+func (x *RetryConfig) GetRetryAllErrors() bool {
+	if x != nil {
+		return x.RetryAllErrors
+	}
+	return false
+}
+
+type StartBackupReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to MessageOrResult:
+	//
+	//	*StartBackupReply_Message
+	//	*StartBackupReply_Result
+	MessageOrResult isStartBackupReply_MessageOrResult `protobuf_oneof:"MessageOrResult"`
+}
+
+func (x *StartBackupReply) Reset() {
+	*x = StartBackupReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartBackupReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartBackupReply) ProtoMessage() {}
+
+func (x *StartBackupReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartBackupReply.ProtoReflect.Descriptor instead.
+func (*StartBackupReply) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{22}
+}
+
+func (m *StartBackupReply) GetMessageOrResult() isStartBackupReply_MessageOrResult {
+	if m != nil {
+		return m.MessageOrResult
+	}
+	return nil
+}
+
+func (x *StartBackupReply) GetMessage() *OutputMessage {
+	if x, ok := x.GetMessageOrResult().(*StartBackupReply_Message); ok {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *StartBackupReply) GetResult() *StartBackupResult {
+	if x, ok := x.GetMessageOrResult().(*StartBackupReply_Result); ok {
+		return x.Result
+	}
+	return nil
+}
+
+type isStartBackupReply_MessageOrResult interface {
+	isStartBackupReply_MessageOrResult()
+}
+
+type StartBackupReply_Message struct {
+	Message *OutputMessage `protobuf:"bytes,1,opt,name=message,proto3,oneof"`
+}
+
+type StartBackupReply_Result struct {
+	Result *StartBackupResult `protobuf:"bytes,2,opt,name=result,proto3,oneof"`
+}
+
+func (*StartBackupReply_Message) isStartBackupReply_MessageOrResult() {}
+
+func (*StartBackupReply_Result) isStartBackupReply_MessageOrResult() {}
+
+type StartBackupResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BackuperId uint32 `protobuf:"varint,1,opt,name=backuperId,proto3" json:"backuperId,omitempty"`
+	// The case sensitivity depends on the file system, so clients detect it for each mount point.
+	// Still sent with the default of the server OS, for older clients.
+	//
+	// Deprecated: Do not use.
+	CaseSensitive bool `protobuf:"varint,2,opt,name=caseSensitive,proto3" json:"caseSensitive,omitempty"`
+	// Rounded up. Use leaseTimeInMs if available.
+	LeaseTimeInSec int32 `protobuf:"varint,3,opt,name=leaseTimeInSec,proto3" json:"leaseTimeInSec,omitempty"`
+	LeaseTimeInMs  int64 `protobuf:"varint,4,opt,name=leaseTimeInMs,proto3" json:"leaseTimeInMs,omitempty"`
+}
+
+func (x *StartBackupResult) Reset() {
+	*x = StartBackupResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartBackupResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartBackupResult) ProtoMessage() {}
+
+func (x *StartBackupResult) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartBackupResult.ProtoReflect.Descriptor instead.
+func (*StartBackupResult) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *StartBackupResult) GetBackuperId() uint32 {
+	if x != nil {
+		return x.BackuperId
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (x *StartBackupResult) GetCaseSensitive() bool {
+	if x != nil {
+		return x.CaseSensitive
+	}
+	return false
+}
+
+func (x *StartBackupResult) GetLeaseTimeInSec() int32 {
+	if x != nil {
+		return x.LeaseTimeInSec
+	}
+	return 0
+}
+
+func (x *StartBackupResult) GetLeaseTimeInMs() int64 {
+	if x != nil {
+		return x.LeaseTimeInMs
+	}
+	return 0
+}
+
+type TryToCreateTemporarySnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BackuperId uint32 `protobuf:"varint,1,opt,name=backuperId,proto3" json:"backuperId,omitempty"`
+	Dir        string `protobuf:"bytes,2,opt,name=dir,proto3" json:"dir,omitempty"`
+}
+
+func (x *TryToCreateTemporarySnapshotRequest) Reset() {
+	*x = TryToCreateTemporarySnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TryToCreateTemporarySnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TryToCreateTemporarySnapshotRequest) ProtoMessage() {}
+
+func (x *TryToCreateTemporarySnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TryToCreateTemporarySnapshotRequest.ProtoReflect.Descriptor instead.
+func (*TryToCreateTemporarySnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TryToCreateTemporarySnapshotRequest) GetBackuperId() uint32 {
+	if x != nil {
+		return x.BackuperId
+	}
+	return 0
+}
+
+func (x *TryToCreateTemporarySnapshotRequest) GetDir() string {
+	if x != nil {
+		return x.Dir
+	}
+	return ""
+}
+
+type TryToCreateTemporarySnapshotReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to MessageOrResult:
+	//
+	//	*TryToCreateTemporarySnapshotReply_Message
+	//	*TryToCreateTemporarySnapshotReply_Result
+	MessageOrResult isTryToCreateTemporarySnapshotReply_MessageOrResult `protobuf_oneof:"MessageOrResult"`
+}
+
+func (x *TryToCreateTemporarySnapshotReply) Reset() {
+	*x = TryToCreateTemporarySnapshotReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TryToCreateTemporarySnapshotReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TryToCreateTemporarySnapshotReply) ProtoMessage() {}
+
+func (x *TryToCreateTemporarySnapshotReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TryToCreateTemporarySnapshotReply.ProtoReflect.Descriptor instead.
+func (*TryToCreateTemporarySnapshotReply) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{25}
+}
+
+func (m *TryToCreateTemporarySnapshotReply) GetMessageOrResult() isTryToCreateTemporarySnapshotReply_MessageOrResult {
+	if m != nil {
+		return m.MessageOrResult
+	}
+	return nil
+}
+
+func (x *TryToCreateTemporarySnapshotReply) GetMessage() *OutputMessage {
+	if x, ok := x.GetMessageOrResult().(*TryToCreateTemporarySnapshotReply_Message); ok {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *TryToCreateTemporarySnapshotReply) GetResult() *TryToCreateTemporarySnapshotResult {
+	if x, ok := x.GetMessageOrResult().(*TryToCreateTemporarySnapshotReply_Result); ok {
+		return x.Result
+	}
+	return nil
+}
+
+type isTryToCreateTemporarySnapshotReply_MessageOrResult interface {
+	isTryToCreateTemporarySnapshotReply_MessageOrResult()
+}
+
+type TryToCreateTemporarySnapshotReply_Message struct {
+	Message *OutputMessage `protobuf:"bytes,1,opt,name=message,proto3,oneof"`
+}
+
+type TryToCreateTemporarySnapshotReply_Result struct {
+	Result *TryToCreateTemporarySnapshotResult `protobuf:"bytes,2,opt,name=result,proto3,oneof"`
+}
+
+func (*TryToCreateTemporarySnapshotReply_Message) isTryToCreateTemporarySnapshotReply_MessageOrResult() {
+}
+
+func (*TryToCreateTemporarySnapshotReply_Result) isTryToCreateTemporarySnapshotReply_MessageOrResult() {
+}
+
+type TryToCreateTemporarySnapshotResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SnapshotDir string    `protobuf:"bytes,1,opt,name=snapshotDir,proto3" json:"snapshotDir,omitempty"`
+	Snapshot    *Snapshot `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+}
+
+func (x *TryToCreateTemporarySnapshotResult) Reset() {
+	*x = TryToCreateTemporarySnapshotResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TryToCreateTemporarySnapshotResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TryToCreateTemporarySnapshotResult) ProtoMessage() {}
+
+func (x *TryToCreateTemporarySnapshotResult) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TryToCreateTemporarySnapshotResult.ProtoReflect.Descriptor instead.
+func (*TryToCreateTemporarySnapshotResult) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TryToCreateTemporarySnapshotResult) GetSnapshotDir() string {
+	if x != nil {
+		return x.SnapshotDir
+	}
+	return ""
+}
+
+func (x *TryToCreateTemporarySnapshotResult) GetSnapshot() *Snapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+type CloseBackupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BackuperId uint32 `protobuf:"varint,1,opt,name=backuperId,proto3" json:"backuperId,omitempty"`
+}
+
+func (x *CloseBackupRequest) Reset() {
+	*x = CloseBackupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseBackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseBackupRequest) ProtoMessage() {}
+
+func (x *CloseBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseBackupRequest.ProtoReflect.Descriptor instead.
+func (*CloseBackupRequest) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CloseBackupRequest) GetBackuperId() uint32 {
+	if x != nil {
+		return x.BackuperId
+	}
+	return 0
+}
+
+type CloseBackupReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *OutputMessage `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *CloseBackupReply) Reset() {
+	*x = CloseBackupReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseBackupReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseBackupReply) ProtoMessage() {}
+
+func (x *CloseBackupReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseBackupReply.ProtoReflect.Descriptor instead.
+func (*CloseBackupReply) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CloseBackupReply) GetMessage() *OutputMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type RenewBackupLeaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BackuperId uint32 `protobuf:"varint,1,opt,name=backuperId,proto3" json:"backuperId,omitempty"`
+}
+
+func (x *RenewBackupLeaseRequest) Reset() {
+	*x = RenewBackupLeaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenewBackupLeaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewBackupLeaseRequest) ProtoMessage() {}
+
+func (x *RenewBackupLeaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewBackupLeaseRequest.ProtoReflect.Descriptor instead.
+func (*RenewBackupLeaseRequest) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RenewBackupLeaseRequest) GetBackuperId() uint32 {
+	if x != nil {
+		return x.BackuperId
+	}
+	return 0
+}
+
+type RenewBackupLeaseReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RenewBackupLeaseReply) Reset() {
+	*x = RenewBackupLeaseReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenewBackupLeaseReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewBackupLeaseReply) ProtoMessage() {}
+
+func (x *RenewBackupLeaseReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewBackupLeaseReply.ProtoReflect.Descriptor instead.
+func (*RenewBackupLeaseReply) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{30}
+}
+
+type ListFailedSnapshotsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BackuperId uint32 `protobuf:"varint,1,opt,name=backuperId,proto3" json:"backuperId,omitempty"`
+}
+
+func (x *ListFailedSnapshotsRequest) Reset() {
+	*x = ListFailedSnapshotsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFailedSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFailedSnapshotsRequest) ProtoMessage() {}
+
+func (x *ListFailedSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFailedSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*ListFailedSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListFailedSnapshotsRequest) GetBackuperId() uint32 {
+	if x != nil {
+		return x.BackuperId
+	}
+	return 0
+}
+
+type ListFailedSnapshotsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Failures []*SnapshotFailure `protobuf:"bytes,1,rep,name=failures,proto3" json:"failures,omitempty"`
+}
+
+func (x *ListFailedSnapshotsReply) Reset() {
+	*x = ListFailedSnapshotsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFailedSnapshotsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFailedSnapshotsReply) ProtoMessage() {}
+
+func (x *ListFailedSnapshotsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFailedSnapshotsReply.ProtoReflect.Descriptor instead.
+func (*ListFailedSnapshotsReply) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListFailedSnapshotsReply) GetFailures() []*SnapshotFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+type SnapshotFailure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Snapshot *Snapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	Reason   string    `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Time     int64     `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *SnapshotFailure) Reset() {
+	*x = SnapshotFailure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotFailure) ProtoMessage() {}
+
+func (x *SnapshotFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotFailure.ProtoReflect.Descriptor instead.
+func (*SnapshotFailure) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SnapshotFailure) GetSnapshot() *Snapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *SnapshotFailure) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SnapshotFailure) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+type GetBackupReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BackuperId uint32 `protobuf:"varint,1,opt,name=backuperId,proto3" json:"backuperId,omitempty"`
+}
+
+func (x *GetBackupReportRequest) Reset() {
+	*x = GetBackupReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBackupReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBackupReportRequest) ProtoMessage() {}
+
+func (x *GetBackupReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBackupReportRequest.ProtoReflect.Descriptor instead.
+func (*GetBackupReportRequest) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetBackupReportRequest) GetBackuperId() uint32 {
+	if x != nil {
+		return x.BackuperId
+	}
+	return 0
+}
+
+type GetBackupReportReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Directories []*DirectoryReport `protobuf:"bytes,1,rep,name=directories,proto3" json:"directories,omitempty"`
+}
+
+func (x *GetBackupReportReply) Reset() {
+	*x = GetBackupReportReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBackupReportReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBackupReportReply) ProtoMessage() {}
+
+func (x *GetBackupReportReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBackupReportReply.ProtoReflect.Descriptor instead.
+func (*GetBackupReportReply) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetBackupReportReply) GetDirectories() []*DirectoryReport {
+	if x != nil {
+		return x.Directories
+	}
+	return nil
+}
+
+type DirectoryReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Directory      string          `protobuf:"bytes,1,opt,name=directory,proto3" json:"directory,omitempty"`
+	MountPoint     string          `protobuf:"bytes,2,opt,name=mountPoint,proto3" json:"mountPoint,omitempty"`
+	Status         DirectoryStatus `protobuf:"varint,3,opt,name=status,proto3,enum=fs_snapshot.v1.DirectoryStatus" json:"status,omitempty"`
+	Path           string          `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	Snapshot       *Snapshot       `protobuf:"bytes,5,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	ProviderId     string          `protobuf:"bytes,6,opt,name=providerId,proto3" json:"providerId,omitempty"`
+	FallbackReason string          `protobuf:"bytes,7,opt,name=fallbackReason,proto3" json:"fallbackReason,omitempty"`
+	Error          string          `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	StartTime      int64           `protobuf:"varint,9,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	DurationInMs   int64           `protobuf:"varint,10,opt,name=durationInMs,proto3" json:"durationInMs,omitempty"`
+}
+
+func (x *DirectoryReport) Reset() {
+	*x = DirectoryReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DirectoryReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DirectoryReport) ProtoMessage() {}
+
+func (x *DirectoryReport) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DirectoryReport.ProtoReflect.Descriptor instead.
+func (*DirectoryReport) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DirectoryReport) GetDirectory() string {
+	if x != nil {
+		return x.Directory
+	}
+	return ""
+}
+
+func (x *DirectoryReport) GetMountPoint() string {
+	if x != nil {
+		return x.MountPoint
+	}
+	return ""
+}
+
+func (x *DirectoryReport) GetStatus() DirectoryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DirectoryStatus_DirectorySnapshoted
+}
+
+func (x *DirectoryReport) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DirectoryReport) GetSnapshot() *Snapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *DirectoryReport) GetProviderId() string {
+	if x != nil {
+		return x.ProviderId
+	}
+	return ""
+}
+
+func (x *DirectoryReport) GetFallbackReason() string {
+	if x != nil {
+		return x.FallbackReason
+	}
+	return ""
+}
+
+func (x *DirectoryReport) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DirectoryReport) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *DirectoryReport) GetDurationInMs() int64 {
+	if x != nil {
+		return x.DurationInMs
+	}
+	return 0
+}
+
+type CleanupTemporarySnapshotsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CleanupTemporarySnapshotsRequest) Reset() {
+	*x = CleanupTemporarySnapshotsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CleanupTemporarySnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CleanupTemporarySnapshotsRequest) ProtoMessage() {}
+
+func (x *CleanupTemporarySnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CleanupTemporarySnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*CleanupTemporarySnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{37}
+}
+
+type CleanupTemporarySnapshotsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cleaned int32 `protobuf:"varint,1,opt,name=cleaned,proto3" json:"cleaned,omitempty"`
+}
+
+func (x *CleanupTemporarySnapshotsReply) Reset() {
+	*x = CleanupTemporarySnapshotsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CleanupTemporarySnapshotsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CleanupTemporarySnapshotsReply) ProtoMessage() {}
+
+func (x *CleanupTemporarySnapshotsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CleanupTemporarySnapshotsReply.ProtoReflect.Descriptor instead.
+func (*CleanupTemporarySnapshotsReply) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CleanupTemporarySnapshotsReply) GetCleaned() int32 {
+	if x != nil {
+		return x.Cleaned
+	}
+	return 0
+}
+
+type StatSnapshotFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BackuperId uint32 `protobuf:"varint,1,opt,name=backuperId,proto3" json:"backuperId,omitempty"`
+	SnapshotId string `protobuf:"bytes,2,opt,name=snapshotId,proto3" json:"snapshotId,omitempty"`
+	Path       string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *StatSnapshotFileRequest) Reset() {
+	*x = StatSnapshotFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatSnapshotFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatSnapshotFileRequest) ProtoMessage() {}
+
+func (x *StatSnapshotFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatSnapshotFileRequest.ProtoReflect.Descriptor instead.
+func (*StatSnapshotFileRequest) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *StatSnapshotFileRequest) GetBackuperId() uint32 {
+	if x != nil {
+		return x.BackuperId
+	}
+	return 0
+}
+
+func (x *StatSnapshotFileRequest) GetSnapshotId() string {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return ""
+}
+
+func (x *StatSnapshotFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type StatSnapshotFileReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Info *FileInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+}
+
+func (x *StatSnapshotFileReply) Reset() {
+	*x = StatSnapshotFileReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatSnapshotFileReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatSnapshotFileReply) ProtoMessage() {}
+
+func (x *StatSnapshotFileReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatSnapshotFileReply.ProtoReflect.Descriptor instead.
+func (*StatSnapshotFileReply) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *StatSnapshotFileReply) GetInfo() *FileInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+type ReadSnapshotDirRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BackuperId uint32 `protobuf:"varint,1,opt,name=backuperId,proto3" json:"backuperId,omitempty"`
+	SnapshotId string `protobuf:"bytes,2,opt,name=snapshotId,proto3" json:"snapshotId,omitempty"`
+	Path       string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *ReadSnapshotDirRequest) Reset() {
+	*x = ReadSnapshotDirRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadSnapshotDirRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadSnapshotDirRequest) ProtoMessage() {}
+
+func (x *ReadSnapshotDirRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadSnapshotDirRequest.ProtoReflect.Descriptor instead.
+func (*ReadSnapshotDirRequest) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ReadSnapshotDirRequest) GetBackuperId() uint32 {
+	if x != nil {
+		return x.BackuperId
+	}
+	return 0
+}
+
+func (x *ReadSnapshotDirRequest) GetSnapshotId() string {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return ""
+}
+
+func (x *ReadSnapshotDirRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type ReadSnapshotDirReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Large folders are sent in more than one reply.
+	Entries []*FileInfo `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *ReadSnapshotDirReply) Reset() {
+	*x = ReadSnapshotDirReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadSnapshotDirReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadSnapshotDirReply) ProtoMessage() {}
+
+func (x *ReadSnapshotDirReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadSnapshotDirReply.ProtoReflect.Descriptor instead.
+func (*ReadSnapshotDirReply) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ReadSnapshotDirReply) GetEntries() []*FileInfo {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type ReadSnapshotFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BackuperId uint32 `protobuf:"varint,1,opt,name=backuperId,proto3" json:"backuperId,omitempty"`
+	SnapshotId string `protobuf:"bytes,2,opt,name=snapshotId,proto3" json:"snapshotId,omitempty"`
+	Path       string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Offset     int64  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	// 0 means until the end of the file.
+	Length int64 `protobuf:"varint,5,opt,name=length,proto3" json:"length,omitempty"`
+}
+
+func (x *ReadSnapshotFileRequest) Reset() {
+	*x = ReadSnapshotFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadSnapshotFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadSnapshotFileRequest) ProtoMessage() {}
+
+func (x *ReadSnapshotFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadSnapshotFileRequest.ProtoReflect.Descriptor instead.
+func (*ReadSnapshotFileRequest) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ReadSnapshotFileRequest) GetBackuperId() uint32 {
+	if x != nil {
+		return x.BackuperId
+	}
+	return 0
+}
+
+func (x *ReadSnapshotFileRequest) GetSnapshotId() string {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return ""
+}
+
+func (x *ReadSnapshotFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ReadSnapshotFileRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ReadSnapshotFileRequest) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+type ReadSnapshotFileReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ReadSnapshotFileReply) Reset() {
+	*x = ReadSnapshotFileReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadSnapshotFileReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadSnapshotFileReply) ProtoMessage() {}
+
+func (x *ReadSnapshotFileReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadSnapshotFileReply.ProtoReflect.Descriptor instead.
+func (*ReadSnapshotFileReply) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ReadSnapshotFileReply) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type FileInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Size int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// Mode in io/fs.FileMode format.
+	Mode uint32 `protobuf:"varint,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	// Nanoseconds since epoch.
+	ModTime int64 `protobuf:"varint,4,opt,name=modTime,proto3" json:"modTime,omitempty"`
+	// Only filled for symbolic links.
+	LinkTarget string `protobuf:"bytes,5,opt,name=linkTarget,proto3" json:"linkTarget,omitempty"`
+	// Ownership, when the server OS has it. uid and gid are -1 if unknown.
+	Uid   int64  `protobuf:"varint,6,opt,name=uid,proto3" json:"uid,omitempty"`
+	Gid   int64  `protobuf:"varint,7,opt,name=gid,proto3" json:"gid,omitempty"`
+	User  string `protobuf:"bytes,8,opt,name=user,proto3" json:"user,omitempty"`
+	Group string `protobuf:"bytes,9,opt,name=group,proto3" json:"group,omitempty"`
+	// Identify hard links, when links > 1.
+	Device uint64   `protobuf:"varint,10,opt,name=device,proto3" json:"device,omitempty"`
+	Inode  uint64   `protobuf:"varint,11,opt,name=inode,proto3" json:"inode,omitempty"`
+	Links  uint64   `protobuf:"varint,12,opt,name=links,proto3" json:"links,omitempty"`
+	Xattrs []*Xattr `protobuf:"bytes,13,rep,name=xattrs,proto3" json:"xattrs,omitempty"`
+}
+
+func (x *FileInfo) Reset() {
+	*x = FileInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileInfo) ProtoMessage() {}
+
+func (x *FileInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileInfo.ProtoReflect.Descriptor instead.
+func (*FileInfo) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *FileInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FileInfo) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *FileInfo) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *FileInfo) GetModTime() int64 {
+	if x != nil {
+		return x.ModTime
+	}
+	return 0
+}
+
+func (x *FileInfo) GetLinkTarget() string {
+	if x != nil {
+		return x.LinkTarget
+	}
+	return ""
+}
+
+func (x *FileInfo) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *FileInfo) GetGid() int64 {
+	if x != nil {
+		return x.Gid
+	}
+	return 0
+}
+
+func (x *FileInfo) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *FileInfo) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *FileInfo) GetDevice() uint64 {
+	if x != nil {
+		return x.Device
+	}
+	return 0
+}
+
+func (x *FileInfo) GetInode() uint64 {
+	if x != nil {
+		return x.Inode
+	}
+	return 0
+}
+
+func (x *FileInfo) GetLinks() uint64 {
+	if x != nil {
+		return x.Links
+	}
+	return 0
+}
+
+func (x *FileInfo) GetXattrs() []*Xattr {
+	if x != nil {
+		return x.Xattrs
+	}
+	return nil
+}
+
+type Xattr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Xattr) Reset() {
+	*x = Xattr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Xattr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Xattr) ProtoMessage() {}
+
+func (x *Xattr) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Xattr.ProtoReflect.Descriptor instead.
+func (*Xattr) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Xattr) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Xattr) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type Provider struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Type    string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *Provider) Reset() {
+	*x = Provider{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Provider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Provider) ProtoMessage() {}
+
+func (x *Provider) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Provider.ProtoReflect.Descriptor instead.
+func (*Provider) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Provider) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Provider) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Provider) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Provider) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type SnapshotSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                      string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreationTime            int64       `protobuf:"varint,2,opt,name=creationTime,proto3" json:"creationTime,omitempty"`
+	SnapshotCountOnCreation int32       `protobuf:"varint,3,opt,name=snapshotCountOnCreation,proto3" json:"snapshotCountOnCreation,omitempty"`
+	Snapshots               []*Snapshot `protobuf:"bytes,4,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+}
+
+func (x *SnapshotSet) Reset() {
+	*x = SnapshotSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotSet) ProtoMessage() {}
+
+func (x *SnapshotSet) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotSet.ProtoReflect.Descriptor instead.
+func (*SnapshotSet) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SnapshotSet) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SnapshotSet) GetCreationTime() int64 {
+	if x != nil {
+		return x.CreationTime
+	}
+	return 0
+}
+
+func (x *SnapshotSet) GetSnapshotCountOnCreation() int32 {
+	if x != nil {
+		return x.SnapshotCountOnCreation
+	}
+	return 0
+}
+
+func (x *SnapshotSet) GetSnapshots() []*Snapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+type Snapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OriginalDir  string        `protobuf:"bytes,2,opt,name=originalDir,proto3" json:"originalDir,omitempty"`
+	SnapshotDir  string        `protobuf:"bytes,3,opt,name=snapshotDir,proto3" json:"snapshotDir,omitempty"`
+	CreationTime int64         `protobuf:"varint,4,opt,name=creationTime,proto3" json:"creationTime,omitempty"`
+	Set          *SnapshotSet  `protobuf:"bytes,5,opt,name=set,proto3" json:"set,omitempty"`
+	Provider     *Provider     `protobuf:"bytes,6,opt,name=provider,proto3" json:"provider,omitempty"`
+	State        string        `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`
+	Attributes   string        `protobuf:"bytes,8,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	Size         *SnapshotSize `protobuf:"bytes,9,opt,name=size,proto3" json:"size,omitempty"` // Not set if unknown
+}
+
+func (x *Snapshot) Reset() {
+	*x = Snapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Snapshot) ProtoMessage() {}
+
+func (x *Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Snapshot.ProtoReflect.Descriptor instead.
+func (*Snapshot) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *Snapshot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Snapshot) GetOriginalDir() string {
+	if x != nil {
+		return x.OriginalDir
+	}
+	return ""
+}
+
+func (x *Snapshot) GetSnapshotDir() string {
+	if x != nil {
+		return x.SnapshotDir
+	}
+	return ""
+}
+
+func (x *Snapshot) GetCreationTime() int64 {
+	if x != nil {
+		return x.CreationTime
+	}
+	return 0
+}
+
+func (x *Snapshot) GetSet() *SnapshotSet {
+	if x != nil {
+		return x.Set
+	}
+	return nil
+}
+
+func (x *Snapshot) GetProvider() *Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+func (x *Snapshot) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Snapshot) GetAttributes() string {
+	if x != nil {
+		return x.Attributes
+	}
+	return ""
+}
+
+func (x *Snapshot) GetSize() *SnapshotSize {
+	if x != nil {
+		return x.Size
+	}
+	return nil
+}
+
+type SnapshotSize struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Exclusive int64   `protobuf:"varint,1,opt,name=exclusive,proto3" json:"exclusive,omitempty"` // -1 if unknown
+	Shared    int64   `protobuf:"varint,2,opt,name=shared,proto3" json:"shared,omitempty"`       // -1 if unknown
+	CowUsage  float64 `protobuf:"fixed64,3,opt,name=cowUsage,proto3" json:"cowUsage,omitempty"`  // Percentage, -1 if unknown
+}
+
+func (x *SnapshotSize) Reset() {
+	*x = SnapshotSize{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotSize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotSize) ProtoMessage() {}
+
+func (x *SnapshotSize) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotSize.ProtoReflect.Descriptor instead.
+func (*SnapshotSize) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SnapshotSize) GetExclusive() int64 {
+	if x != nil {
+		return x.Exclusive
+	}
+	return 0
+}
+
+func (x *SnapshotSize) GetShared() int64 {
+	if x != nil {
+		return x.Shared
+	}
+	return 0
+}
+
+func (x *SnapshotSize) GetCowUsage() float64 {
+	if x != nil {
+		return x.CowUsage
+	}
+	return 0
+}
+
+type OutputMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level   MessageLevel `protobuf:"varint,1,opt,name=level,proto3,enum=fs_snapshot.v1.MessageLevel" json:"level,omitempty"`
+	Message string       `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *OutputMessage) Reset() {
+	*x = OutputMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fs_snapshot_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutputMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutputMessage) ProtoMessage() {}
+
+func (x *OutputMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_fs_snapshot_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutputMessage.ProtoReflect.Descriptor instead.
+func (*OutputMessage) Descriptor() ([]byte, []int) {
+	return file_fs_snapshot_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *OutputMessage) GetLevel() MessageLevel {
+	if x != nil {
+		return x.Level
+	}
+	return MessageLevel_OutputLevel
+}
+
+func (x *OutputMessage) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_fs_snapshot_proto protoreflect.FileDescriptor
+
+var file_fs_snapshot_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x2e, 0x76, 0x31, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xf0, 0x01, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a,
+	0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x61, 0x72, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x63, 0x68,
+	0x12, 0x36, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x0d, 0x63, 0x61, 0x73, 0x65,
+	0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42,
+	0x02, 0x18, 0x01, 0x52, 0x0d, 0x63, 0x61, 0x73, 0x65, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x1b,
+	0x0a, 0x19, 0x43, 0x61, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2b, 0x0a, 0x17, 0x43,
+	0x61, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x61, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x03, 0x63, 0x61, 0x6e, 0x22, 0x32, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x12,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x36, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x22, 0x2d, 0x0a, 0x0f, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0x40, 0x0a, 0x0d, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x65, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2f, 0x0a, 0x04, 0x73, 0x65,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x53, 0x65, 0x74, 0x52, 0x04, 0x73, 0x65, 0x74, 0x73, 0x22, 0x32, 0x0a, 0x14, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x4c, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x36, 0x0a, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x22, 0x23, 0x0a,
+	0x11, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x66, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x2d, 0x0a, 0x0f, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x66, 0x79, 0x49, 0x64,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x49,
+	0x64, 0x22, 0x35, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x27, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x22, 0x30, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x22, 0x38, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x75, 0x6e, 0x74,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0b, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x2f, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22, 0xbb,
+	0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x66, 0x72, 0x65, 0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x66, 0x72, 0x65, 0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x2a, 0x0a, 0x10, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x4d, 0x61, 0x78,
+	0x53, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x73, 0x4d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xe0, 0x02, 0x0a,
+	0x12, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x49, 0x6e,
+	0x53, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x49, 0x6e, 0x53, 0x65, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x6d, 0x70, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x12,
+	0x3d, 0x0a, 0x09, 0x66, 0x72, 0x65, 0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x09, 0x66, 0x72, 0x65, 0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x37,
+	0x0a, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07,
+	0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79, 0x12, 0x43, 0x0a, 0x0d, 0x66, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1d, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22,
+	0xf2, 0x01, 0x0a, 0x0f, 0x46, 0x72, 0x65, 0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x46, 0x72, 0x65, 0x65, 0x53, 0x70,
+	0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x46, 0x72,
+	0x65, 0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x46, 0x72,
+	0x65, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0e, 0x6d, 0x69, 0x6e, 0x46, 0x72, 0x65, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12,
+	0x2a, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x53,
+	0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x66, 0x73,
+	0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x72, 0x65,
+	0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x73, 0x22, 0xc7, 0x01, 0x0a, 0x0d, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x49, 0x6e, 0x53, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x49, 0x6e, 0x53, 0x65, 0x63, 0x12, 0x26, 0x0a, 0x0e,
+	0x77, 0x61, 0x72, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x01, 0x52, 0x0e, 0x77, 0x61, 0x72, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x54, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x64, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x9b,
+	0x01, 0x0a, 0x0b, 0x52, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a,
+	0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x61,
+	0x63, 0x6b, 0x6f, 0x66, 0x66, 0x49, 0x6e, 0x4d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x49, 0x6e, 0x4d, 0x73, 0x12, 0x26, 0x0a, 0x0e,
+	0x6d, 0x61, 0x78, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x49, 0x6e, 0x4d, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66,
+	0x49, 0x6e, 0x4d, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x74, 0x72, 0x79, 0x41, 0x6c, 0x6c,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x72, 0x65,
+	0x74, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x9d, 0x01, 0x0a,
+	0x10, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x39, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3b, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x66,
+	0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48,
+	0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x11, 0x0a, 0x0f, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x4f, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xab, 0x01, 0x0a,
+	0x11, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x28, 0x0a, 0x0d, 0x63, 0x61, 0x73, 0x65, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0d, 0x63,
+	0x61, 0x73, 0x65, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x12, 0x26, 0x0a, 0x0e,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x53, 0x65, 0x63, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x49,
+	0x6e, 0x53, 0x65, 0x63, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x49, 0x6e, 0x4d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x4d, 0x73, 0x22, 0x57, 0x0a, 0x23, 0x54, 0x72,
+	0x79, 0x54, 0x6f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
+	0x72, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x64, 0x69, 0x72, 0x22, 0xbf, 0x01, 0x0a, 0x21, 0x54, 0x72, 0x79, 0x54, 0x6f, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x39, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x66, 0x73, 0x5f,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x4c, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x79, 0x54, 0x6f, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x42, 0x11, 0x0a, 0x0f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x72, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x7c, 0x0a, 0x22, 0x54, 0x72, 0x79, 0x54, 0x6f, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x44, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x44, 0x69, 0x72, 0x12, 0x34, 0x0a,
+	0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x22, 0x34, 0x0a, 0x12, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x62,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4b, 0x0a, 0x10, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x39, 0x0a, 0x17, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x42,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x3c, 0x0a, 0x1a, 0x4c, 0x69,
+	0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x62, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x65, 0x72, 0x49, 0x64, 0x22, 0x57, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x3b, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x73, 0x22, 0x73, 0x0a, 0x0f, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x38, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x59, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x41, 0x0a, 0x0b, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x0b,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0xf2, 0x02, 0x0a, 0x0f,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x37, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e,
+	0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x34, 0x0a, 0x08, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66,
+	0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x26, 0x0a, 0x0e, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x4d, 0x73, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x4d, 0x73,
+	0x22, 0x22, 0x0a, 0x20, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6f,
+	0x72, 0x61, 0x72, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x0a, 0x1e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54,
+	0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x64,
+	0x22, 0x6d, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22,
+	0x45, 0x0a, 0x15, 0x53, 0x74, 0x61, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x46,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2c, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x6c, 0x0a, 0x16, 0x52, 0x65, 0x61, 0x64, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x44, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x22, 0x4a, 0x0a, 0x14, 0x52, 0x65, 0x61, 0x64, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x44, 0x69, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x32, 0x0a, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x22, 0x9d, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x61, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x22, 0x2b, 0x0a, 0x15, 0x52, 0x65, 0x61, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xc1, 0x02,
+	0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x6f, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x69, 0x6e, 0x6b, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x69, 0x6e, 0x6b, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75,
+	0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x03, 0x67, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x16,
+	0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6e,
+	0x6b, 0x73, 0x12, 0x2d, 0x0a, 0x06, 0x78, 0x61, 0x74, 0x74, 0x72, 0x73, 0x18, 0x0d, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x58, 0x61, 0x74, 0x74, 0x72, 0x52, 0x06, 0x78, 0x61, 0x74, 0x74, 0x72,
+	0x73, 0x22, 0x31, 0x0a, 0x05, 0x58, 0x61, 0x74, 0x74, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x5c, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x22, 0xb3, 0x01, 0x0a, 0x0b, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53,
+	0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x17, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x17, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x36, 0x0a, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x09, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x22, 0xcf, 0x02, 0x0a, 0x08, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x6c, 0x44, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x61, 0x6c, 0x44, 0x69, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x44, 0x69, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x44, 0x69, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2d, 0x0a,
+	0x03, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x73, 0x5f,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x53, 0x65, 0x74, 0x52, 0x03, 0x73, 0x65, 0x74, 0x12, 0x34, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x53, 0x69, 0x7a, 0x65, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x60, 0x0a, 0x0c, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78,
+	0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65,
+	0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x77, 0x55, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x63, 0x6f, 0x77, 0x55, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5d, 0x0a, 0x0d,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x32, 0x0a,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x66,
+	0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0x2f, 0x0a, 0x0f, 0x46,
+	0x72, 0x65, 0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0a,
+	0x0a, 0x06, 0x52, 0x65, 0x66, 0x75, 0x73, 0x65, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x10, 0x01, 0x2a, 0x32, 0x0a, 0x0d,
+	0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x0c, 0x0a,
+	0x08, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x53,
+	0x6b, 0x69, 0x70, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x10, 0x02,
+	0x2a, 0x6d, 0x0a, 0x0f, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x65, 0x64, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x10, 0x03, 0x2a,
+	0x50, 0x0a, 0x0c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x0f, 0x0a, 0x0b, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x10, 0x00,
+	0x12, 0x0d, 0x0a, 0x09, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x10, 0x01, 0x12,
+	0x10, 0x0a, 0x0c, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x10,
+	0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x10,
+	0x03, 0x32, 0xba, 0x0f, 0x0a, 0x0a, 0x46, 0x73, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x12, 0x5b, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x24, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x6a, 0x0a,
+	0x12, 0x43, 0x61, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x73, 0x12, 0x29, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x61, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0d, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x24, 0x2e, 0x66, 0x73, 0x5f,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65,
+	0x74, 0x73, 0x12, 0x1f, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x74, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x66, 0x73,
+	0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x52, 0x0a, 0x0a, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x66, 0x79, 0x49, 0x64, 0x12,
+	0x21, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x66, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x66, 0x79, 0x49, 0x64, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x65, 0x74, 0x12, 0x1d, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x4e, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x12, 0x1d, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x61, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x12, 0x26, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x66, 0x73,
+	0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x25, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x66,
+	0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x12, 0x22, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x30, 0x01, 0x12, 0x8a, 0x01, 0x0a,
+	0x1c, 0x54, 0x72, 0x79, 0x54, 0x6f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70,
+	0x6f, 0x72, 0x61, 0x72, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x33, 0x2e,
+	0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x72, 0x79, 0x54, 0x6f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6f, 0x72,
+	0x61, 0x72, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x31, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x79, 0x54, 0x6f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x30, 0x01, 0x12, 0x57, 0x0a, 0x0b, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x22, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x42,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x66,
+	0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x64, 0x0a, 0x10, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x42, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x27, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x42, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12,
+	0x2a, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x66, 0x73,
+	0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x26, 0x2e, 0x66, 0x73, 0x5f,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x7f, 0x0a, 0x19, 0x43, 0x6c,
+	0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x30, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70,
+	0x54, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x66, 0x73, 0x5f, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e,
+	0x75, 0x70, 0x54, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x10, 0x53,
+	0x74, 0x61, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12,
+	0x27, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x63, 0x0a, 0x0f, 0x52, 0x65, 0x61, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x44, 0x69, 0x72, 0x12, 0x26, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x44, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x66,
+	0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x61, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x44, 0x69, 0x72, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x30, 0x01, 0x12, 0x66, 0x0a, 0x10, 0x52, 0x65, 0x61, 0x64, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x27, 0x2e, 0x66, 0x73, 0x5f,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x30, 0x01, 0x42, 0x3a,
+	0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x65, 0x73,
+	0x63, 0x75, 0x6d, 0x61, 0x2f, 0x67, 0x6f, 0x2d, 0x66, 0x73, 0x2d, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x2f, 0x66, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_fs_snapshot_proto_rawDescOnce sync.Once
+	file_fs_snapshot_proto_rawDescData = file_fs_snapshot_proto_rawDesc
+)
+
+func file_fs_snapshot_proto_rawDescGZIP() []byte {
+	file_fs_snapshot_proto_rawDescOnce.Do(func() {
+		file_fs_snapshot_proto_rawDescData = protoimpl.X.CompressGZIP(file_fs_snapshot_proto_rawDescData)
+	})
+	return file_fs_snapshot_proto_rawDescData
+}
+
+var file_fs_snapshot_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_fs_snapshot_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
+var file_fs_snapshot_proto_goTypes = []interface{}{
+	(FreeSpaceAction)(0),                        // 0: fs_snapshot.v1.FreeSpaceAction
+	(FailurePolicy)(0),                          // 1: fs_snapshot.v1.FailurePolicy
+	(DirectoryStatus)(0),                        // 2: fs_snapshot.v1.DirectoryStatus
+	(MessageLevel)(0),                           // 3: fs_snapshot.v1.MessageLevel
+	(*GetServerInfoRequest)(nil),                // 4: fs_snapshot.v1.GetServerInfoRequest
+	(*GetServerInfoReply)(nil),                  // 5: fs_snapshot.v1.GetServerInfoReply
+	(*CanCreateSnapshotsRequest)(nil),           // 6: fs_snapshot.v1.CanCreateSnapshotsRequest
+	(*CanCreateSnapshotsReply)(nil),             // 7: fs_snapshot.v1.CanCreateSnapshotsReply
+	(*ListProvidersRequest)(nil),                // 8: fs_snapshot.v1.ListProvidersRequest
+	(*ListProvidersReply)(nil),                  // 9: fs_snapshot.v1.ListProvidersReply
+	(*ListSetsRequest)(nil),                     // 10: fs_snapshot.v1.ListSetsRequest
+	(*ListSetsReply)(nil),                       // 11: fs_snapshot.v1.ListSetsReply
+	(*ListSnapshotsRequest)(nil),                // 12: fs_snapshot.v1.ListSnapshotsRequest
+	(*ListSnapshotsReply)(nil),                  // 13: fs_snapshot.v1.ListSnapshotsReply
+	(*SimplifyIdRequest)(nil),                   // 14: fs_snapshot.v1.SimplifyIdRequest
+	(*SimplifyIdReply)(nil),                     // 15: fs_snapshot.v1.SimplifyIdReply
+	(*DeleteRequest)(nil),                       // 16: fs_snapshot.v1.DeleteRequest
+	(*DeleteReply)(nil),                         // 17: fs_snapshot.v1.DeleteReply
+	(*ListMountPointsRequest)(nil),              // 18: fs_snapshot.v1.ListMountPointsRequest
+	(*ListMountPointsReply)(nil),                // 19: fs_snapshot.v1.ListMountPointsReply
+	(*GetVolumeUsageRequest)(nil),               // 20: fs_snapshot.v1.GetVolumeUsageRequest
+	(*GetVolumeUsageReply)(nil),                 // 21: fs_snapshot.v1.GetVolumeUsageReply
+	(*StartBackupRequest)(nil),                  // 22: fs_snapshot.v1.StartBackupRequest
+	(*FreeSpaceConfig)(nil),                     // 23: fs_snapshot.v1.FreeSpaceConfig
+	(*MonitorConfig)(nil),                       // 24: fs_snapshot.v1.MonitorConfig
+	(*RetryConfig)(nil),                         // 25: fs_snapshot.v1.RetryConfig
+	(*StartBackupReply)(nil),                    // 26: fs_snapshot.v1.StartBackupReply
+	(*StartBackupResult)(nil),                   // 27: fs_snapshot.v1.StartBackupResult
+	(*TryToCreateTemporarySnapshotRequest)(nil), // 28: fs_snapshot.v1.TryToCreateTemporarySnapshotRequest
+	(*TryToCreateTemporarySnapshotReply)(nil),   // 29: fs_snapshot.v1.TryToCreateTemporarySnapshotReply
+	(*TryToCreateTemporarySnapshotResult)(nil),  // 30: fs_snapshot.v1.TryToCreateTemporarySnapshotResult
+	(*CloseBackupRequest)(nil),                  // 31: fs_snapshot.v1.CloseBackupRequest
+	(*CloseBackupReply)(nil),                    // 32: fs_snapshot.v1.CloseBackupReply
+	(*RenewBackupLeaseRequest)(nil),             // 33: fs_snapshot.v1.RenewBackupLeaseRequest
+	(*RenewBackupLeaseReply)(nil),               // 34: fs_snapshot.v1.RenewBackupLeaseReply
+	(*ListFailedSnapshotsRequest)(nil),          // 35: fs_snapshot.v1.ListFailedSnapshotsRequest
+	(*ListFailedSnapshotsReply)(nil),            // 36: fs_snapshot.v1.ListFailedSnapshotsReply
+	(*SnapshotFailure)(nil),                     // 37: fs_snapshot.v1.SnapshotFailure
+	(*GetBackupReportRequest)(nil),              // 38: fs_snapshot.v1.GetBackupReportRequest
+	(*GetBackupReportReply)(nil),                // 39: fs_snapshot.v1.GetBackupReportReply
+	(*DirectoryReport)(nil),                     // 40: fs_snapshot.v1.DirectoryReport
+	(*CleanupTemporarySnapshotsRequest)(nil),    // 41: fs_snapshot.v1.CleanupTemporarySnapshotsRequest
+	(*CleanupTemporarySnapshotsReply)(nil),      // 42: fs_snapshot.v1.CleanupTemporarySnapshotsReply
+	(*StatSnapshotFileRequest)(nil),             // 43: fs_snapshot.v1.StatSnapshotFileRequest
+	(*StatSnapshotFileReply)(nil),               // 44: fs_snapshot.v1.StatSnapshotFileReply
+	(*ReadSnapshotDirRequest)(nil),              // 45: fs_snapshot.v1.ReadSnapshotDirRequest
+	(*ReadSnapshotDirReply)(nil),                // 46: fs_snapshot.v1.ReadSnapshotDirReply
+	(*ReadSnapshotFileRequest)(nil),             // 47: fs_snapshot.v1.ReadSnapshotFileRequest
+	(*ReadSnapshotFileReply)(nil),               // 48: fs_snapshot.v1.ReadSnapshotFileReply
+	(*FileInfo)(nil),                            // 49: fs_snapshot.v1.FileInfo
+	(*Xattr)(nil),                               // 50: fs_snapshot.v1.Xattr
+	(*Provider)(nil),                            // 51: fs_snapshot.v1.Provider
+	(*SnapshotSet)(nil),                         // 52: fs_snapshot.v1.SnapshotSet
+	(*Snapshot)(nil),                            // 53: fs_snapshot.v1.Snapshot
+	(*SnapshotSize)(nil),                        // 54: fs_snapshot.v1.SnapshotSize
+	(*OutputMessage)(nil),                       // 55: fs_snapshot.v1.OutputMessage
+}
+var file_fs_snapshot_proto_depIdxs = []int32{
+	51, // 0: fs_snapshot.v1.GetServerInfoReply.providers:type_name -> fs_snapshot.v1.Provider
+	51, // 1: fs_snapshot.v1.ListProvidersReply.providers:type_name -> fs_snapshot.v1.Provider
+	52, // 2: fs_snapshot.v1.ListSetsReply.sets:type_name -> fs_snapshot.v1.SnapshotSet
+	53, // 3: fs_snapshot.v1.ListSnapshotsReply.snapshots:type_name -> fs_snapshot.v1.Snapshot
+	23, // 4: fs_snapshot.v1.StartBackupRequest.freeSpace:type_name -> fs_snapshot.v1.FreeSpaceConfig
+	24, // 5: fs_snapshot.v1.StartBackupRequest.monitor:type_name -> fs_snapshot.v1.MonitorConfig
+	25, // 6: fs_snapshot.v1.StartBackupRequest.retry:type_name -> fs_snapshot.v1.RetryConfig
+	1,  // 7: fs_snapshot.v1.StartBackupRequest.failurePolicy:type_name -> fs_snapshot.v1.FailurePolicy
+	0,  // 8: fs_snapshot.v1.FreeSpaceConfig.action:type_name -> fs_snapshot.v1.FreeSpaceAction
+	55, // 9: fs_snapshot.v1.StartBackupReply.message:type_name -> fs_snapshot.v1.OutputMessage
+	27, // 10: fs_snapshot.v1.StartBackupReply.result:type_name -> fs_snapshot.v1.StartBackupResult
+	55, // 11: fs_snapshot.v1.TryToCreateTemporarySnapshotReply.message:type_name -> fs_snapshot.v1.OutputMessage
+	30, // 12: fs_snapshot.v1.TryToCreateTemporarySnapshotReply.result:type_name -> fs_snapshot.v1.TryToCreateTemporarySnapshotResult
+	53, // 13: fs_snapshot.v1.TryToCreateTemporarySnapshotResult.snapshot:type_name -> fs_snapshot.v1.Snapshot
+	55, // 14: fs_snapshot.v1.CloseBackupReply.message:type_name -> fs_snapshot.v1.OutputMessage
+	37, // 15: fs_snapshot.v1.ListFailedSnapshotsReply.failures:type_name -> fs_snapshot.v1.SnapshotFailure
+	53, // 16: fs_snapshot.v1.SnapshotFailure.snapshot:type_name -> fs_snapshot.v1.Snapshot
+	40, // 17: fs_snapshot.v1.GetBackupReportReply.directories:type_name -> fs_snapshot.v1.DirectoryReport
+	2,  // 18: fs_snapshot.v1.DirectoryReport.status:type_name -> fs_snapshot.v1.DirectoryStatus
+	53, // 19: fs_snapshot.v1.DirectoryReport.snapshot:type_name -> fs_snapshot.v1.Snapshot
+	49, // 20: fs_snapshot.v1.StatSnapshotFileReply.info:type_name -> fs_snapshot.v1.FileInfo
+	49, // 21: fs_snapshot.v1.ReadSnapshotDirReply.entries:type_name -> fs_snapshot.v1.FileInfo
+	50, // 22: fs_snapshot.v1.FileInfo.xattrs:type_name -> fs_snapshot.v1.Xattr
+	53, // 23: fs_snapshot.v1.SnapshotSet.snapshots:type_name -> fs_snapshot.v1.Snapshot
+	52, // 24: fs_snapshot.v1.Snapshot.set:type_name -> fs_snapshot.v1.SnapshotSet
+	51, // 25: fs_snapshot.v1.Snapshot.provider:type_name -> fs_snapshot.v1.Provider
+	54, // 26: fs_snapshot.v1.Snapshot.size:type_name -> fs_snapshot.v1.SnapshotSize
+	3,  // 27: fs_snapshot.v1.OutputMessage.level:type_name -> fs_snapshot.v1.MessageLevel
+	4,  // 28: fs_snapshot.v1.FsSnapshot.GetServerInfo:input_type -> fs_snapshot.v1.GetServerInfoRequest
+	6,  // 29: fs_snapshot.v1.FsSnapshot.CanCreateSnapshots:input_type -> fs_snapshot.v1.CanCreateSnapshotsRequest
+	8,  // 30: fs_snapshot.v1.FsSnapshot.ListProviders:input_type -> fs_snapshot.v1.ListProvidersRequest
+	10, // 31: fs_snapshot.v1.FsSnapshot.ListSets:input_type -> fs_snapshot.v1.ListSetsRequest
+	12, // 32: fs_snapshot.v1.FsSnapshot.ListSnapshots:input_type -> fs_snapshot.v1.ListSnapshotsRequest
+	14, // 33: fs_snapshot.v1.FsSnapshot.SimplifyId:input_type -> fs_snapshot.v1.SimplifyIdRequest
+	16, // 34: fs_snapshot.v1.FsSnapshot.DeleteSet:input_type -> fs_snapshot.v1.DeleteRequest
+	16, // 35: fs_snapshot.v1.FsSnapshot.DeleteSnapshot:input_type -> fs_snapshot.v1.DeleteRequest
+	18, // 36: fs_snapshot.v1.FsSnapshot.ListMountPoints:input_type -> fs_snapshot.v1.ListMountPointsRequest
+	20, // 37: fs_snapshot.v1.FsSnapshot.GetVolumeUsage:input_type -> fs_snapshot.v1.GetVolumeUsageRequest
+	22, // 38: fs_snapshot.v1.FsSnapshot.StartBackup:input_type -> fs_snapshot.v1.StartBackupRequest
+	28, // 39: fs_snapshot.v1.FsSnapshot.TryToCreateTemporarySnapshot:input_type -> fs_snapshot.v1.TryToCreateTemporarySnapshotRequest
+	31, // 40: fs_snapshot.v1.FsSnapshot.CloseBackup:input_type -> fs_snapshot.v1.CloseBackupRequest
+	33, // 41: fs_snapshot.v1.FsSnapshot.RenewBackupLease:input_type -> fs_snapshot.v1.RenewBackupLeaseRequest
+	35, // 42: fs_snapshot.v1.FsSnapshot.ListFailedSnapshots:input_type -> fs_snapshot.v1.ListFailedSnapshotsRequest
+	38, // 43: fs_snapshot.v1.FsSnapshot.GetBackupReport:input_type -> fs_snapshot.v1.GetBackupReportRequest
+	41, // 44: fs_snapshot.v1.FsSnapshot.CleanupTemporarySnapshots:input_type -> fs_snapshot.v1.CleanupTemporarySnapshotsRequest
+	43, // 45: fs_snapshot.v1.FsSnapshot.StatSnapshotFile:input_type -> fs_snapshot.v1.StatSnapshotFileRequest
+	45, // 46: fs_snapshot.v1.FsSnapshot.ReadSnapshotDir:input_type -> fs_snapshot.v1.ReadSnapshotDirRequest
+	47, // 47: fs_snapshot.v1.FsSnapshot.ReadSnapshotFile:input_type -> fs_snapshot.v1.ReadSnapshotFileRequest
+	5,  // 48: fs_snapshot.v1.FsSnapshot.GetServerInfo:output_type -> fs_snapshot.v1.GetServerInfoReply
+	7,  // 49: fs_snapshot.v1.FsSnapshot.CanCreateSnapshots:output_type -> fs_snapshot.v1.CanCreateSnapshotsReply
+	9,  // 50: fs_snapshot.v1.FsSnapshot.ListProviders:output_type -> fs_snapshot.v1.ListProvidersReply
+	11, // 51: fs_snapshot.v1.FsSnapshot.ListSets:output_type -> fs_snapshot.v1.ListSetsReply
+	13, // 52: fs_snapshot.v1.FsSnapshot.ListSnapshots:output_type -> fs_snapshot.v1.ListSnapshotsReply
+	15, // 53: fs_snapshot.v1.FsSnapshot.SimplifyId:output_type -> fs_snapshot.v1.SimplifyIdReply
+	17, // 54: fs_snapshot.v1.FsSnapshot.DeleteSet:output_type -> fs_snapshot.v1.DeleteReply
+	17, // 55: fs_snapshot.v1.FsSnapshot.DeleteSnapshot:output_type -> fs_snapshot.v1.DeleteReply
+	19, // 56: fs_snapshot.v1.FsSnapshot.ListMountPoints:output_type -> fs_snapshot.v1.ListMountPointsReply
+	21, // 57: fs_snapshot.v1.FsSnapshot.GetVolumeUsage:output_type -> fs_snapshot.v1.GetVolumeUsageReply
+	26, // 58: fs_snapshot.v1.FsSnapshot.StartBackup:output_type -> fs_snapshot.v1.StartBackupReply
+	29, // 59: fs_snapshot.v1.FsSnapshot.TryToCreateTemporarySnapshot:output_type -> fs_snapshot.v1.TryToCreateTemporarySnapshotReply
+	32, // 60: fs_snapshot.v1.FsSnapshot.CloseBackup:output_type -> fs_snapshot.v1.CloseBackupReply
+	34, // 61: fs_snapshot.v1.FsSnapshot.RenewBackupLease:output_type -> fs_snapshot.v1.RenewBackupLeaseReply
+	36, // 62: fs_snapshot.v1.FsSnapshot.ListFailedSnapshots:output_type -> fs_snapshot.v1.ListFailedSnapshotsReply
+	39, // 63: fs_snapshot.v1.FsSnapshot.GetBackupReport:output_type -> fs_snapshot.v1.GetBackupReportReply
+	42, // 64: fs_snapshot.v1.FsSnapshot.CleanupTemporarySnapshots:output_type -> fs_snapshot.v1.CleanupTemporarySnapshotsReply
+	44, // 65: fs_snapshot.v1.FsSnapshot.StatSnapshotFile:output_type -> fs_snapshot.v1.StatSnapshotFileReply
+	46, // 66: fs_snapshot.v1.FsSnapshot.ReadSnapshotDir:output_type -> fs_snapshot.v1.ReadSnapshotDirReply
+	48, // 67: fs_snapshot.v1.FsSnapshot.ReadSnapshotFile:output_type -> fs_snapshot.v1.ReadSnapshotFileReply
+	48, // [48:68] is the sub-list for method output_type
+	28, // [28:48] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
+}
+
+func init() { file_fs_snapshot_proto_init() }
+func file_fs_snapshot_proto_init() {
+	if File_fs_snapshot_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_fs_snapshot_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServerInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServerInfoReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CanCreateSnapshotsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CanCreateSnapshotsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProvidersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProvidersReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSetsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSnapshotsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSnapshotsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimplifyIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimplifyIdReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMountPointsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMountPointsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVolumeUsageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVolumeUsageReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartBackupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FreeSpaceConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MonitorConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetryConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartBackupReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartBackupResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TryToCreateTemporarySnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TryToCreateTemporarySnapshotReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TryToCreateTemporarySnapshotResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseBackupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseBackupReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenewBackupLeaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenewBackupLeaseReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFailedSnapshotsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFailedSnapshotsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotFailure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBackupReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBackupReportReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DirectoryReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CleanupTemporarySnapshotsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CleanupTemporarySnapshotsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatSnapshotFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatSnapshotFileReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadSnapshotDirRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadSnapshotDirReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadSnapshotFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadSnapshotFileReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Xattr); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Provider); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Snapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotSize); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fs_snapshot_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutputMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_fs_snapshot_proto_msgTypes[22].OneofWrappers = []interface{}{
+		(*StartBackupReply_Message)(nil),
+		(*StartBackupReply_Result)(nil),
+	}
+	file_fs_snapshot_proto_msgTypes[25].OneofWrappers = []interface{}{
+		(*TryToCreateTemporarySnapshotReply_Message)(nil),
+		(*TryToCreateTemporarySnapshotReply_Result)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_fs_snapshot_proto_rawDesc,
+			NumEnums:      4,
+			NumMessages:   52,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_fs_snapshot_proto_goTypes,
+		DependencyIndexes: file_fs_snapshot_proto_depIdxs,
+		EnumInfos:         file_fs_snapshot_proto_enumTypes,
+		MessageInfos:      file_fs_snapshot_proto_msgTypes,
+	}.Build()
+	File_fs_snapshot_proto = out.File
+	file_fs_snapshot_proto_rawDesc = nil
+	file_fs_snapshot_proto_goTypes = nil
+	file_fs_snapshot_proto_depIdxs = nil
+}
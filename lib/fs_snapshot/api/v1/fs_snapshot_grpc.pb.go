@@ -2,9 +2,9 @@
 // versions:
 // - protoc-gen-go-grpc v1.2.0
 // - protoc             v3.21.5
-// source: server.proto
+// source: fs_snapshot.proto
 
-package rpc
+package v1
 
 import (
 	context "context"
@@ -22,6 +22,7 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type FsSnapshotClient interface {
+	GetServerInfo(ctx context.Context, in *GetServerInfoRequest, opts ...grpc.CallOption) (*GetServerInfoReply, error)
 	CanCreateSnapshots(ctx context.Context, in *CanCreateSnapshotsRequest, opts ...grpc.CallOption) (*CanCreateSnapshotsReply, error)
 	ListProviders(ctx context.Context, in *ListProvidersRequest, opts ...grpc.CallOption) (*ListProvidersReply, error)
 	ListSets(ctx context.Context, in *ListSetsRequest, opts ...grpc.CallOption) (*ListSetsReply, error)
@@ -30,9 +31,17 @@ type FsSnapshotClient interface {
 	DeleteSet(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteReply, error)
 	DeleteSnapshot(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteReply, error)
 	ListMountPoints(ctx context.Context, in *ListMountPointsRequest, opts ...grpc.CallOption) (*ListMountPointsReply, error)
+	GetVolumeUsage(ctx context.Context, in *GetVolumeUsageRequest, opts ...grpc.CallOption) (*GetVolumeUsageReply, error)
 	StartBackup(ctx context.Context, in *StartBackupRequest, opts ...grpc.CallOption) (FsSnapshot_StartBackupClient, error)
 	TryToCreateTemporarySnapshot(ctx context.Context, in *TryToCreateTemporarySnapshotRequest, opts ...grpc.CallOption) (FsSnapshot_TryToCreateTemporarySnapshotClient, error)
 	CloseBackup(ctx context.Context, in *CloseBackupRequest, opts ...grpc.CallOption) (FsSnapshot_CloseBackupClient, error)
+	RenewBackupLease(ctx context.Context, in *RenewBackupLeaseRequest, opts ...grpc.CallOption) (*RenewBackupLeaseReply, error)
+	ListFailedSnapshots(ctx context.Context, in *ListFailedSnapshotsRequest, opts ...grpc.CallOption) (*ListFailedSnapshotsReply, error)
+	GetBackupReport(ctx context.Context, in *GetBackupReportRequest, opts ...grpc.CallOption) (*GetBackupReportReply, error)
+	CleanupTemporarySnapshots(ctx context.Context, in *CleanupTemporarySnapshotsRequest, opts ...grpc.CallOption) (*CleanupTemporarySnapshotsReply, error)
+	StatSnapshotFile(ctx context.Context, in *StatSnapshotFileRequest, opts ...grpc.CallOption) (*StatSnapshotFileReply, error)
+	ReadSnapshotDir(ctx context.Context, in *ReadSnapshotDirRequest, opts ...grpc.CallOption) (FsSnapshot_ReadSnapshotDirClient, error)
+	ReadSnapshotFile(ctx context.Context, in *ReadSnapshotFileRequest, opts ...grpc.CallOption) (FsSnapshot_ReadSnapshotFileClient, error)
 }
 
 type fsSnapshotClient struct {
@@ -43,9 +52,18 @@ func NewFsSnapshotClient(cc grpc.ClientConnInterface) FsSnapshotClient {
 	return &fsSnapshotClient{cc}
 }
 
+func (c *fsSnapshotClient) GetServerInfo(ctx context.Context, in *GetServerInfoRequest, opts ...grpc.CallOption) (*GetServerInfoReply, error) {
+	out := new(GetServerInfoReply)
+	err := c.cc.Invoke(ctx, "/fs_snapshot.v1.FsSnapshot/GetServerInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fsSnapshotClient) CanCreateSnapshots(ctx context.Context, in *CanCreateSnapshotsRequest, opts ...grpc.CallOption) (*CanCreateSnapshotsReply, error) {
 	out := new(CanCreateSnapshotsReply)
-	err := c.cc.Invoke(ctx, "/rpc.FsSnapshot/CanCreateSnapshots", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/fs_snapshot.v1.FsSnapshot/CanCreateSnapshots", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -54,7 +72,7 @@ func (c *fsSnapshotClient) CanCreateSnapshots(ctx context.Context, in *CanCreate
 
 func (c *fsSnapshotClient) ListProviders(ctx context.Context, in *ListProvidersRequest, opts ...grpc.CallOption) (*ListProvidersReply, error) {
 	out := new(ListProvidersReply)
-	err := c.cc.Invoke(ctx, "/rpc.FsSnapshot/ListProviders", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/fs_snapshot.v1.FsSnapshot/ListProviders", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -63,7 +81,7 @@ func (c *fsSnapshotClient) ListProviders(ctx context.Context, in *ListProvidersR
 
 func (c *fsSnapshotClient) ListSets(ctx context.Context, in *ListSetsRequest, opts ...grpc.CallOption) (*ListSetsReply, error) {
 	out := new(ListSetsReply)
-	err := c.cc.Invoke(ctx, "/rpc.FsSnapshot/ListSets", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/fs_snapshot.v1.FsSnapshot/ListSets", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +90,7 @@ func (c *fsSnapshotClient) ListSets(ctx context.Context, in *ListSetsRequest, op
 
 func (c *fsSnapshotClient) ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsReply, error) {
 	out := new(ListSnapshotsReply)
-	err := c.cc.Invoke(ctx, "/rpc.FsSnapshot/ListSnapshots", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/fs_snapshot.v1.FsSnapshot/ListSnapshots", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -81,7 +99,7 @@ func (c *fsSnapshotClient) ListSnapshots(ctx context.Context, in *ListSnapshotsR
 
 func (c *fsSnapshotClient) SimplifyId(ctx context.Context, in *SimplifyIdRequest, opts ...grpc.CallOption) (*SimplifyIdReply, error) {
 	out := new(SimplifyIdReply)
-	err := c.cc.Invoke(ctx, "/rpc.FsSnapshot/SimplifyId", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/fs_snapshot.v1.FsSnapshot/SimplifyId", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -90,7 +108,7 @@ func (c *fsSnapshotClient) SimplifyId(ctx context.Context, in *SimplifyIdRequest
 
 func (c *fsSnapshotClient) DeleteSet(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteReply, error) {
 	out := new(DeleteReply)
-	err := c.cc.Invoke(ctx, "/rpc.FsSnapshot/DeleteSet", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/fs_snapshot.v1.FsSnapshot/DeleteSet", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -99,7 +117,7 @@ func (c *fsSnapshotClient) DeleteSet(ctx context.Context, in *DeleteRequest, opt
 
 func (c *fsSnapshotClient) DeleteSnapshot(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteReply, error) {
 	out := new(DeleteReply)
-	err := c.cc.Invoke(ctx, "/rpc.FsSnapshot/DeleteSnapshot", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/fs_snapshot.v1.FsSnapshot/DeleteSnapshot", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -108,7 +126,16 @@ func (c *fsSnapshotClient) DeleteSnapshot(ctx context.Context, in *DeleteRequest
 
 func (c *fsSnapshotClient) ListMountPoints(ctx context.Context, in *ListMountPointsRequest, opts ...grpc.CallOption) (*ListMountPointsReply, error) {
 	out := new(ListMountPointsReply)
-	err := c.cc.Invoke(ctx, "/rpc.FsSnapshot/ListMountPoints", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/fs_snapshot.v1.FsSnapshot/ListMountPoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fsSnapshotClient) GetVolumeUsage(ctx context.Context, in *GetVolumeUsageRequest, opts ...grpc.CallOption) (*GetVolumeUsageReply, error) {
+	out := new(GetVolumeUsageReply)
+	err := c.cc.Invoke(ctx, "/fs_snapshot.v1.FsSnapshot/GetVolumeUsage", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -116,7 +143,7 @@ func (c *fsSnapshotClient) ListMountPoints(ctx context.Context, in *ListMountPoi
 }
 
 func (c *fsSnapshotClient) StartBackup(ctx context.Context, in *StartBackupRequest, opts ...grpc.CallOption) (FsSnapshot_StartBackupClient, error) {
-	stream, err := c.cc.NewStream(ctx, &FsSnapshot_ServiceDesc.Streams[0], "/rpc.FsSnapshot/StartBackup", opts...)
+	stream, err := c.cc.NewStream(ctx, &FsSnapshot_ServiceDesc.Streams[0], "/fs_snapshot.v1.FsSnapshot/StartBackup", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -148,7 +175,7 @@ func (x *fsSnapshotStartBackupClient) Recv() (*StartBackupReply, error) {
 }
 
 func (c *fsSnapshotClient) TryToCreateTemporarySnapshot(ctx context.Context, in *TryToCreateTemporarySnapshotRequest, opts ...grpc.CallOption) (FsSnapshot_TryToCreateTemporarySnapshotClient, error) {
-	stream, err := c.cc.NewStream(ctx, &FsSnapshot_ServiceDesc.Streams[1], "/rpc.FsSnapshot/TryToCreateTemporarySnapshot", opts...)
+	stream, err := c.cc.NewStream(ctx, &FsSnapshot_ServiceDesc.Streams[1], "/fs_snapshot.v1.FsSnapshot/TryToCreateTemporarySnapshot", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -180,7 +207,7 @@ func (x *fsSnapshotTryToCreateTemporarySnapshotClient) Recv() (*TryToCreateTempo
 }
 
 func (c *fsSnapshotClient) CloseBackup(ctx context.Context, in *CloseBackupRequest, opts ...grpc.CallOption) (FsSnapshot_CloseBackupClient, error) {
-	stream, err := c.cc.NewStream(ctx, &FsSnapshot_ServiceDesc.Streams[2], "/rpc.FsSnapshot/CloseBackup", opts...)
+	stream, err := c.cc.NewStream(ctx, &FsSnapshot_ServiceDesc.Streams[2], "/fs_snapshot.v1.FsSnapshot/CloseBackup", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -211,10 +238,120 @@ func (x *fsSnapshotCloseBackupClient) Recv() (*CloseBackupReply, error) {
 	return m, nil
 }
 
+func (c *fsSnapshotClient) RenewBackupLease(ctx context.Context, in *RenewBackupLeaseRequest, opts ...grpc.CallOption) (*RenewBackupLeaseReply, error) {
+	out := new(RenewBackupLeaseReply)
+	err := c.cc.Invoke(ctx, "/fs_snapshot.v1.FsSnapshot/RenewBackupLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fsSnapshotClient) ListFailedSnapshots(ctx context.Context, in *ListFailedSnapshotsRequest, opts ...grpc.CallOption) (*ListFailedSnapshotsReply, error) {
+	out := new(ListFailedSnapshotsReply)
+	err := c.cc.Invoke(ctx, "/fs_snapshot.v1.FsSnapshot/ListFailedSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fsSnapshotClient) GetBackupReport(ctx context.Context, in *GetBackupReportRequest, opts ...grpc.CallOption) (*GetBackupReportReply, error) {
+	out := new(GetBackupReportReply)
+	err := c.cc.Invoke(ctx, "/fs_snapshot.v1.FsSnapshot/GetBackupReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fsSnapshotClient) CleanupTemporarySnapshots(ctx context.Context, in *CleanupTemporarySnapshotsRequest, opts ...grpc.CallOption) (*CleanupTemporarySnapshotsReply, error) {
+	out := new(CleanupTemporarySnapshotsReply)
+	err := c.cc.Invoke(ctx, "/fs_snapshot.v1.FsSnapshot/CleanupTemporarySnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fsSnapshotClient) StatSnapshotFile(ctx context.Context, in *StatSnapshotFileRequest, opts ...grpc.CallOption) (*StatSnapshotFileReply, error) {
+	out := new(StatSnapshotFileReply)
+	err := c.cc.Invoke(ctx, "/fs_snapshot.v1.FsSnapshot/StatSnapshotFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fsSnapshotClient) ReadSnapshotDir(ctx context.Context, in *ReadSnapshotDirRequest, opts ...grpc.CallOption) (FsSnapshot_ReadSnapshotDirClient, error) {
+	stream, err := c.cc.NewStream(ctx, &FsSnapshot_ServiceDesc.Streams[3], "/fs_snapshot.v1.FsSnapshot/ReadSnapshotDir", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &fsSnapshotReadSnapshotDirClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FsSnapshot_ReadSnapshotDirClient interface {
+	Recv() (*ReadSnapshotDirReply, error)
+	grpc.ClientStream
+}
+
+type fsSnapshotReadSnapshotDirClient struct {
+	grpc.ClientStream
+}
+
+func (x *fsSnapshotReadSnapshotDirClient) Recv() (*ReadSnapshotDirReply, error) {
+	m := new(ReadSnapshotDirReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *fsSnapshotClient) ReadSnapshotFile(ctx context.Context, in *ReadSnapshotFileRequest, opts ...grpc.CallOption) (FsSnapshot_ReadSnapshotFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &FsSnapshot_ServiceDesc.Streams[4], "/fs_snapshot.v1.FsSnapshot/ReadSnapshotFile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &fsSnapshotReadSnapshotFileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FsSnapshot_ReadSnapshotFileClient interface {
+	Recv() (*ReadSnapshotFileReply, error)
+	grpc.ClientStream
+}
+
+type fsSnapshotReadSnapshotFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *fsSnapshotReadSnapshotFileClient) Recv() (*ReadSnapshotFileReply, error) {
+	m := new(ReadSnapshotFileReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // FsSnapshotServer is the server API for FsSnapshot service.
 // All implementations must embed UnimplementedFsSnapshotServer
 // for forward compatibility
 type FsSnapshotServer interface {
+	GetServerInfo(context.Context, *GetServerInfoRequest) (*GetServerInfoReply, error)
 	CanCreateSnapshots(context.Context, *CanCreateSnapshotsRequest) (*CanCreateSnapshotsReply, error)
 	ListProviders(context.Context, *ListProvidersRequest) (*ListProvidersReply, error)
 	ListSets(context.Context, *ListSetsRequest) (*ListSetsReply, error)
@@ -223,9 +360,17 @@ type FsSnapshotServer interface {
 	DeleteSet(context.Context, *DeleteRequest) (*DeleteReply, error)
 	DeleteSnapshot(context.Context, *DeleteRequest) (*DeleteReply, error)
 	ListMountPoints(context.Context, *ListMountPointsRequest) (*ListMountPointsReply, error)
+	GetVolumeUsage(context.Context, *GetVolumeUsageRequest) (*GetVolumeUsageReply, error)
 	StartBackup(*StartBackupRequest, FsSnapshot_StartBackupServer) error
 	TryToCreateTemporarySnapshot(*TryToCreateTemporarySnapshotRequest, FsSnapshot_TryToCreateTemporarySnapshotServer) error
 	CloseBackup(*CloseBackupRequest, FsSnapshot_CloseBackupServer) error
+	RenewBackupLease(context.Context, *RenewBackupLeaseRequest) (*RenewBackupLeaseReply, error)
+	ListFailedSnapshots(context.Context, *ListFailedSnapshotsRequest) (*ListFailedSnapshotsReply, error)
+	GetBackupReport(context.Context, *GetBackupReportRequest) (*GetBackupReportReply, error)
+	CleanupTemporarySnapshots(context.Context, *CleanupTemporarySnapshotsRequest) (*CleanupTemporarySnapshotsReply, error)
+	StatSnapshotFile(context.Context, *StatSnapshotFileRequest) (*StatSnapshotFileReply, error)
+	ReadSnapshotDir(*ReadSnapshotDirRequest, FsSnapshot_ReadSnapshotDirServer) error
+	ReadSnapshotFile(*ReadSnapshotFileRequest, FsSnapshot_ReadSnapshotFileServer) error
 	mustEmbedUnimplementedFsSnapshotServer()
 }
 
@@ -233,6 +378,9 @@ type FsSnapshotServer interface {
 type UnimplementedFsSnapshotServer struct {
 }
 
+func (UnimplementedFsSnapshotServer) GetServerInfo(context.Context, *GetServerInfoRequest) (*GetServerInfoReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServerInfo not implemented")
+}
 func (UnimplementedFsSnapshotServer) CanCreateSnapshots(context.Context, *CanCreateSnapshotsRequest) (*CanCreateSnapshotsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CanCreateSnapshots not implemented")
 }
@@ -257,6 +405,9 @@ func (UnimplementedFsSnapshotServer) DeleteSnapshot(context.Context, *DeleteRequ
 func (UnimplementedFsSnapshotServer) ListMountPoints(context.Context, *ListMountPointsRequest) (*ListMountPointsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMountPoints not implemented")
 }
+func (UnimplementedFsSnapshotServer) GetVolumeUsage(context.Context, *GetVolumeUsageRequest) (*GetVolumeUsageReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVolumeUsage not implemented")
+}
 func (UnimplementedFsSnapshotServer) StartBackup(*StartBackupRequest, FsSnapshot_StartBackupServer) error {
 	return status.Errorf(codes.Unimplemented, "method StartBackup not implemented")
 }
@@ -266,6 +417,27 @@ func (UnimplementedFsSnapshotServer) TryToCreateTemporarySnapshot(*TryToCreateTe
 func (UnimplementedFsSnapshotServer) CloseBackup(*CloseBackupRequest, FsSnapshot_CloseBackupServer) error {
 	return status.Errorf(codes.Unimplemented, "method CloseBackup not implemented")
 }
+func (UnimplementedFsSnapshotServer) RenewBackupLease(context.Context, *RenewBackupLeaseRequest) (*RenewBackupLeaseReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewBackupLease not implemented")
+}
+func (UnimplementedFsSnapshotServer) ListFailedSnapshots(context.Context, *ListFailedSnapshotsRequest) (*ListFailedSnapshotsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFailedSnapshots not implemented")
+}
+func (UnimplementedFsSnapshotServer) GetBackupReport(context.Context, *GetBackupReportRequest) (*GetBackupReportReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBackupReport not implemented")
+}
+func (UnimplementedFsSnapshotServer) CleanupTemporarySnapshots(context.Context, *CleanupTemporarySnapshotsRequest) (*CleanupTemporarySnapshotsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CleanupTemporarySnapshots not implemented")
+}
+func (UnimplementedFsSnapshotServer) StatSnapshotFile(context.Context, *StatSnapshotFileRequest) (*StatSnapshotFileReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StatSnapshotFile not implemented")
+}
+func (UnimplementedFsSnapshotServer) ReadSnapshotDir(*ReadSnapshotDirRequest, FsSnapshot_ReadSnapshotDirServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReadSnapshotDir not implemented")
+}
+func (UnimplementedFsSnapshotServer) ReadSnapshotFile(*ReadSnapshotFileRequest, FsSnapshot_ReadSnapshotFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReadSnapshotFile not implemented")
+}
 func (UnimplementedFsSnapshotServer) mustEmbedUnimplementedFsSnapshotServer() {}
 
 // UnsafeFsSnapshotServer may be embedded to opt out of forward compatibility for this service.
@@ -279,6 +451,24 @@ func RegisterFsSnapshotServer(s grpc.ServiceRegistrar, srv FsSnapshotServer) {
 	s.RegisterService(&FsSnapshot_ServiceDesc, srv)
 }
 
+func _FsSnapshot_GetServerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServerInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FsSnapshotServer).GetServerInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fs_snapshot.v1.FsSnapshot/GetServerInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FsSnapshotServer).GetServerInfo(ctx, req.(*GetServerInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FsSnapshot_CanCreateSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CanCreateSnapshotsRequest)
 	if err := dec(in); err != nil {
@@ -289,7 +479,7 @@ func _FsSnapshot_CanCreateSnapshots_Handler(srv interface{}, ctx context.Context
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpc.FsSnapshot/CanCreateSnapshots",
+		FullMethod: "/fs_snapshot.v1.FsSnapshot/CanCreateSnapshots",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(FsSnapshotServer).CanCreateSnapshots(ctx, req.(*CanCreateSnapshotsRequest))
@@ -307,7 +497,7 @@ func _FsSnapshot_ListProviders_Handler(srv interface{}, ctx context.Context, dec
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpc.FsSnapshot/ListProviders",
+		FullMethod: "/fs_snapshot.v1.FsSnapshot/ListProviders",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(FsSnapshotServer).ListProviders(ctx, req.(*ListProvidersRequest))
@@ -325,7 +515,7 @@ func _FsSnapshot_ListSets_Handler(srv interface{}, ctx context.Context, dec func
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpc.FsSnapshot/ListSets",
+		FullMethod: "/fs_snapshot.v1.FsSnapshot/ListSets",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(FsSnapshotServer).ListSets(ctx, req.(*ListSetsRequest))
@@ -343,7 +533,7 @@ func _FsSnapshot_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpc.FsSnapshot/ListSnapshots",
+		FullMethod: "/fs_snapshot.v1.FsSnapshot/ListSnapshots",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(FsSnapshotServer).ListSnapshots(ctx, req.(*ListSnapshotsRequest))
@@ -361,7 +551,7 @@ func _FsSnapshot_SimplifyId_Handler(srv interface{}, ctx context.Context, dec fu
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpc.FsSnapshot/SimplifyId",
+		FullMethod: "/fs_snapshot.v1.FsSnapshot/SimplifyId",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(FsSnapshotServer).SimplifyId(ctx, req.(*SimplifyIdRequest))
@@ -379,7 +569,7 @@ func _FsSnapshot_DeleteSet_Handler(srv interface{}, ctx context.Context, dec fun
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpc.FsSnapshot/DeleteSet",
+		FullMethod: "/fs_snapshot.v1.FsSnapshot/DeleteSet",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(FsSnapshotServer).DeleteSet(ctx, req.(*DeleteRequest))
@@ -397,7 +587,7 @@ func _FsSnapshot_DeleteSnapshot_Handler(srv interface{}, ctx context.Context, de
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpc.FsSnapshot/DeleteSnapshot",
+		FullMethod: "/fs_snapshot.v1.FsSnapshot/DeleteSnapshot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(FsSnapshotServer).DeleteSnapshot(ctx, req.(*DeleteRequest))
@@ -415,7 +605,7 @@ func _FsSnapshot_ListMountPoints_Handler(srv interface{}, ctx context.Context, d
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpc.FsSnapshot/ListMountPoints",
+		FullMethod: "/fs_snapshot.v1.FsSnapshot/ListMountPoints",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(FsSnapshotServer).ListMountPoints(ctx, req.(*ListMountPointsRequest))
@@ -423,6 +613,24 @@ func _FsSnapshot_ListMountPoints_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FsSnapshot_GetVolumeUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVolumeUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FsSnapshotServer).GetVolumeUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fs_snapshot.v1.FsSnapshot/GetVolumeUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FsSnapshotServer).GetVolumeUsage(ctx, req.(*GetVolumeUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FsSnapshot_StartBackup_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(StartBackupRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -486,13 +694,149 @@ func (x *fsSnapshotCloseBackupServer) Send(m *CloseBackupReply) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _FsSnapshot_RenewBackupLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewBackupLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FsSnapshotServer).RenewBackupLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fs_snapshot.v1.FsSnapshot/RenewBackupLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FsSnapshotServer).RenewBackupLease(ctx, req.(*RenewBackupLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FsSnapshot_ListFailedSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFailedSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FsSnapshotServer).ListFailedSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fs_snapshot.v1.FsSnapshot/ListFailedSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FsSnapshotServer).ListFailedSnapshots(ctx, req.(*ListFailedSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FsSnapshot_GetBackupReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBackupReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FsSnapshotServer).GetBackupReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fs_snapshot.v1.FsSnapshot/GetBackupReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FsSnapshotServer).GetBackupReport(ctx, req.(*GetBackupReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FsSnapshot_CleanupTemporarySnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CleanupTemporarySnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FsSnapshotServer).CleanupTemporarySnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fs_snapshot.v1.FsSnapshot/CleanupTemporarySnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FsSnapshotServer).CleanupTemporarySnapshots(ctx, req.(*CleanupTemporarySnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FsSnapshot_StatSnapshotFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatSnapshotFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FsSnapshotServer).StatSnapshotFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fs_snapshot.v1.FsSnapshot/StatSnapshotFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FsSnapshotServer).StatSnapshotFile(ctx, req.(*StatSnapshotFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FsSnapshot_ReadSnapshotDir_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadSnapshotDirRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FsSnapshotServer).ReadSnapshotDir(m, &fsSnapshotReadSnapshotDirServer{stream})
+}
+
+type FsSnapshot_ReadSnapshotDirServer interface {
+	Send(*ReadSnapshotDirReply) error
+	grpc.ServerStream
+}
+
+type fsSnapshotReadSnapshotDirServer struct {
+	grpc.ServerStream
+}
+
+func (x *fsSnapshotReadSnapshotDirServer) Send(m *ReadSnapshotDirReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _FsSnapshot_ReadSnapshotFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadSnapshotFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FsSnapshotServer).ReadSnapshotFile(m, &fsSnapshotReadSnapshotFileServer{stream})
+}
+
+type FsSnapshot_ReadSnapshotFileServer interface {
+	Send(*ReadSnapshotFileReply) error
+	grpc.ServerStream
+}
+
+type fsSnapshotReadSnapshotFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *fsSnapshotReadSnapshotFileServer) Send(m *ReadSnapshotFileReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // FsSnapshot_ServiceDesc is the grpc.ServiceDesc for FsSnapshot service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var FsSnapshot_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "rpc.FsSnapshot",
+	ServiceName: "fs_snapshot.v1.FsSnapshot",
 	HandlerType: (*FsSnapshotServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetServerInfo",
+			Handler:    _FsSnapshot_GetServerInfo_Handler,
+		},
 		{
 			MethodName: "CanCreateSnapshots",
 			Handler:    _FsSnapshot_CanCreateSnapshots_Handler,
@@ -525,6 +869,30 @@ var FsSnapshot_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMountPoints",
 			Handler:    _FsSnapshot_ListMountPoints_Handler,
 		},
+		{
+			MethodName: "GetVolumeUsage",
+			Handler:    _FsSnapshot_GetVolumeUsage_Handler,
+		},
+		{
+			MethodName: "RenewBackupLease",
+			Handler:    _FsSnapshot_RenewBackupLease_Handler,
+		},
+		{
+			MethodName: "ListFailedSnapshots",
+			Handler:    _FsSnapshot_ListFailedSnapshots_Handler,
+		},
+		{
+			MethodName: "GetBackupReport",
+			Handler:    _FsSnapshot_GetBackupReport_Handler,
+		},
+		{
+			MethodName: "CleanupTemporarySnapshots",
+			Handler:    _FsSnapshot_CleanupTemporarySnapshots_Handler,
+		},
+		{
+			MethodName: "StatSnapshotFile",
+			Handler:    _FsSnapshot_StatSnapshotFile_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -542,6 +910,16 @@ var FsSnapshot_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _FsSnapshot_CloseBackup_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ReadSnapshotDir",
+			Handler:       _FsSnapshot_ReadSnapshotDir_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ReadSnapshotFile",
+			Handler:       _FsSnapshot_ReadSnapshotFile_Handler,
+			ServerStreams: true,
+		},
 	},
-	Metadata: "server.proto",
+	Metadata: "fs_snapshot.proto",
 }
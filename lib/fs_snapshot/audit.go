@@ -0,0 +1,261 @@
+package fs_snapshot
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// auditLog writes one JSON line for each request received by the server. The file is only appended to.
+type auditLog struct {
+	mutex  sync.Mutex
+	file   *os.File
+	syslog io.Writer
+
+	infoCallback InfoMessageCallback
+}
+
+type auditEntry struct {
+	Time             time.Time       `json:"time"`
+	User             string          `json:"user"`
+	Peer             string          `json:"peer,omitempty"`
+	Operation        string          `json:"operation"`
+	Args             json.RawMessage `json:"args,omitempty"`
+	Result           string          `json:"result"`
+	Error            string          `json:"error,omitempty"`
+	CreatedSnapshots []string        `json:"createdSnapshots,omitempty"`
+	DeletedSnapshots []string        `json:"deletedSnapshots,omitempty"`
+	DurationMs       int64           `json:"durationMs"`
+}
+
+// auditRecord is the entry being filled while a request is handled.
+type auditRecord struct {
+	mutex sync.Mutex
+	entry auditEntry
+}
+
+type auditRecordKey struct{}
+
+func newAuditLog(path string, useSyslog bool, infoCb InfoMessageCallback) (*auditLog, error) {
+	result := &auditLog{
+		infoCallback: infoCb,
+	}
+
+	if path != "" {
+		err := os.MkdirAll(filepath.Dir(path), 0o700)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error creating audit log folder %v", filepath.Dir(path))
+		}
+
+		result.file, err = os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error opening audit log %v", path)
+		}
+	}
+
+	if useSyslog {
+		w, err := newSyslogWriter()
+		if err != nil {
+			result.Close()
+			return nil, errors.Wrap(err, "error connecting to syslog")
+		}
+
+		result.syslog = w
+	}
+
+	return result, nil
+}
+
+func (l *auditLog) Close() {
+	if l == nil {
+		return
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.file != nil {
+		_ = l.file.Close()
+		l.file = nil
+	}
+	if c, ok := l.syslog.(io.Closer); ok {
+		_ = c.Close()
+	}
+	l.syslog = nil
+}
+
+func (l *auditLog) Write(entry *auditEntry) {
+	if l == nil {
+		return
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		l.infoCallback(InfoLevel, "Error writing audit log: %v", err)
+		return
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.file != nil {
+		_, err = l.file.Write(append(data, '\n'))
+		if err != nil {
+			l.infoCallback(InfoLevel, "Error writing audit log: %v", err)
+		}
+	}
+
+	if l.syslog != nil {
+		_, err = l.syslog.Write(data)
+		if err != nil {
+			l.infoCallback(InfoLevel, "Error writing audit log to syslog: %v", err)
+		}
+	}
+}
+
+func (l *auditLog) startRecord(ctx context.Context, method string) (context.Context, *auditRecord) {
+	r := &auditRecord{}
+	r.entry.Time = time.Now()
+	r.entry.Operation = method[strings.LastIndex(method, "/")+1:]
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		r.entry.Peer = p.Addr.Network() + ":" + p.Addr.String()
+	}
+
+	return context.WithValue(ctx, auditRecordKey{}, r), r
+}
+
+func (l *auditLog) finishRecord(r *auditRecord, err error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.entry.DurationMs = time.Since(r.entry.Time).Milliseconds()
+
+	st, _ := status.FromError(err)
+	r.entry.Result = st.Code().String()
+	if err != nil {
+		r.entry.Error = st.Message()
+	}
+
+	l.Write(&r.entry)
+}
+
+func (l *auditLog) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	// Health checks are not privileged and would flood the log
+	if isHealthCheck(info.FullMethod) {
+		return handler(ctx, req)
+	}
+
+	ctx, r := l.startRecord(ctx, info.FullMethod)
+	r.setArgs(req)
+
+	reply, err := handler(ctx, req)
+
+	l.finishRecord(r, err)
+
+	return reply, err
+}
+
+func (l *auditLog) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if isHealthCheck(info.FullMethod) {
+		return handler(srv, ss)
+	}
+
+	ctx, r := l.startRecord(ss.Context(), info.FullMethod)
+
+	err := handler(srv, &auditServerStream{ServerStream: ss, ctx: ctx, record: r})
+
+	l.finishRecord(r, err)
+
+	return err
+}
+
+// auditServerStream stores the request as the args of the audit entry.
+type auditServerStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	record *auditRecord
+}
+
+func (s *auditServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *auditServerStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.record.setArgs(m)
+	}
+
+	return err
+}
+
+func (r *auditRecord) setArgs(req interface{}) {
+	m, ok := req.(proto.Message)
+	if !ok {
+		return
+	}
+
+	data, err := protojson.Marshal(m)
+	if err != nil {
+		return
+	}
+
+	r.mutex.Lock()
+	r.entry.Args = data
+	r.mutex.Unlock()
+}
+
+func auditRecordFromContext(ctx context.Context) *auditRecord {
+	r, _ := ctx.Value(auditRecordKey{}).(*auditRecord)
+	return r
+}
+
+func auditCaller(ctx context.Context, username string) {
+	r := auditRecordFromContext(ctx)
+	if r == nil {
+		return
+	}
+
+	r.mutex.Lock()
+	r.entry.User = username
+	r.mutex.Unlock()
+}
+
+func auditCreatedSnapshots(ctx context.Context, ids ...string) {
+	r := auditRecordFromContext(ctx)
+	if r == nil {
+		return
+	}
+
+	r.mutex.Lock()
+	r.entry.CreatedSnapshots = append(r.entry.CreatedSnapshots, ids...)
+	r.mutex.Unlock()
+}
+
+func auditDeletedSnapshots(ctx context.Context, ids ...string) {
+	r := auditRecordFromContext(ctx)
+	if r == nil {
+		return
+	}
+
+	r.mutex.Lock()
+	r.entry.DeletedSnapshots = append(r.entry.DeletedSnapshots, ids...)
+	r.mutex.Unlock()
+}
+
+func isAuditing(ctx context.Context) bool {
+	return auditRecordFromContext(ctx) != nil
+}
@@ -0,0 +1,11 @@
+package fs_snapshot
+
+import (
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+func newSyslogWriter() (io.Writer, error) {
+	return nil, errors.New("syslog is not supported in Windows")
+}
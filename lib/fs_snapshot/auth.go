@@ -0,0 +1,669 @@
+package fs_snapshot
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+type AuthMode int
+
+const (
+	// AuthDefault uses TLS if the certificates were created by EnableSnapshotsForUser, and no authentication otherwise.
+	AuthDefault AuthMode = iota
+	// AuthNone does not authenticate. Anyone that can connect to the server can use it.
+	AuthNone
+	// AuthToken authenticates using a shared secret created by EnableSnapshotsForUser.
+	AuthToken
+	// AuthTLS uses mutual TLS with the certificates created by EnableSnapshotsForUser.
+	AuthTLS
+)
+
+func ParseAuthMode(mode string) (AuthMode, error) {
+	switch strings.ToLower(mode) {
+	case "", "default":
+		return AuthDefault, nil
+	case "none":
+		return AuthNone, nil
+	case "token":
+		return AuthToken, nil
+	case "tls":
+		return AuthTLS, nil
+	default:
+		return AuthDefault, errors.Errorf("unknown auth mode: %v", mode)
+	}
+}
+
+func (m AuthMode) String() string {
+	switch m {
+	case AuthDefault:
+		return "default"
+	case AuthNone:
+		return "none"
+	case AuthToken:
+		return "token"
+	case AuthTLS:
+		return "tls"
+	default:
+		return "unknown"
+	}
+}
+
+// Credentials dir layout:
+//   ca.pem                   - CA certificate, readable by everyone
+//   private/                 - readable only by root/administrators
+//     ca-key.pem
+//     server.pem
+//     server-key.pem
+//   users/<username>/        - readable only by the user
+//     client.pem
+//     client-key.pem
+//     token
+
+const serverCertName = "fs_snapshot"
+const tokenMetadataKey = "authorization"
+const tokenPrefix = "Bearer "
+
+func caCertFile(dir string) string {
+	return filepath.Join(dir, "ca.pem")
+}
+
+func privateDir(dir string) string {
+	return filepath.Join(dir, "private")
+}
+
+func usersDir(dir string) string {
+	return filepath.Join(dir, "users")
+}
+
+func userCredentialsDir(dir string, username string) string {
+	return filepath.Join(usersDir(dir), strings.ReplaceAll(username, `\`, `_`))
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// caller is the authenticated user that sent a request to the server.
+type caller struct {
+	Username string
+}
+
+type callerKey struct{}
+
+func callerFromContext(ctx context.Context) *caller {
+	c, _ := ctx.Value(callerKey{}).(*caller)
+	return c
+}
+
+// serverAuthenticator validates the requests received by the server and adds the caller to the context.
+type serverAuthenticator struct {
+	mode           AuthMode
+	credentialsDir string
+
+	mutex        sync.Mutex
+	tokens       map[string]string
+	tokensLoaded time.Time
+}
+
+// tokenReloadInterval is the minimum time between reloads of the tokens caused by unknown tokens.
+const tokenReloadInterval = 5 * time.Second
+
+func newServerAuthenticator(mode AuthMode, credentialsDir string) (*serverAuthenticator, error) {
+	if mode == AuthDefault {
+		if fileExists(filepath.Join(privateDir(credentialsDir), "server.pem")) {
+			mode = AuthTLS
+		} else {
+			mode = AuthNone
+		}
+	}
+
+	result := &serverAuthenticator{
+		mode:           mode,
+		credentialsDir: credentialsDir,
+	}
+
+	if mode == AuthToken {
+		err := result.loadTokens()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// TransportCredentials returns the credentials to be used by the server. The interceptors must also be
+// registered to authenticate the requests.
+func (a *serverAuthenticator) TransportCredentials() (credentials.TransportCredentials, error) {
+	tcp := insecure.NewCredentials()
+
+	if a.mode == AuthTLS {
+		cfg, err := a.TLSConfig()
+		if err != nil {
+			return nil, err
+		}
+
+		tcp = credentials.NewTLS(cfg)
+	}
+
+	return &serverTransportCredentials{tcp: tcp}, nil
+}
+
+// TLSConfig returns the TLS configuration used by the server in AuthTLS mode.
+func (a *serverAuthenticator) TLSConfig() (*tls.Config, error) {
+	private := privateDir(a.credentialsDir)
+
+	cert, err := tls.LoadX509KeyPair(filepath.Join(private, "server.pem"), filepath.Join(private, "server-key.pem"))
+	if err != nil {
+		return nil, errors.Wrap(err, "error loading server certificate")
+	}
+
+	ca, err := loadCertPool(caCertFile(a.credentialsDir))
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    ca,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+func (a *serverAuthenticator) loadTokens() error {
+	dirs, err := os.ReadDir(usersDir(a.credentialsDir))
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "error loading tokens")
+	}
+
+	// Without the CA the folder names are used
+	ca, _ := loadCertPool(caCertFile(a.credentialsDir))
+
+	tokens := make(map[string]string)
+
+	for _, d := range dirs {
+		if !d.IsDir() {
+			continue
+		}
+
+		dir := filepath.Join(usersDir(a.credentialsDir), d.Name())
+
+		data, err := os.ReadFile(filepath.Join(dir, "token"))
+		if err != nil {
+			continue
+		}
+
+		username := d.Name()
+
+		// The folder name can't have some chars, so use the name in the certificate if possible. The certificate
+		// can be changed by the user, so it is only used if it was signed by the CA and matches the folder name.
+		cert, err := loadCertificateFile(filepath.Join(dir, "client.pem"))
+		if err == nil && ca != nil && verifyClientCertificate(cert, ca) &&
+			userCredentialsDir(a.credentialsDir, cert.Subject.CommonName) == dir {
+			username = cert.Subject.CommonName
+		}
+
+		tokens[strings.TrimSpace(string(data))] = username
+	}
+
+	a.mutex.Lock()
+	a.tokens = tokens
+	a.tokensLoaded = time.Now()
+	a.mutex.Unlock()
+
+	return nil
+}
+
+// reloadTokens is used when a token is not found, because the user may have been enabled after the server
+// started. It does nothing if the tokens were loaded recently, so invalid tokens can't make the server read the
+// disk all the time.
+func (a *serverAuthenticator) reloadTokens() error {
+	a.mutex.Lock()
+
+	if time.Since(a.tokensLoaded) < tokenReloadInterval {
+		a.mutex.Unlock()
+		return nil
+	}
+
+	a.tokensLoaded = time.Now()
+	a.mutex.Unlock()
+
+	return a.loadTokens()
+}
+
+func verifyClientCertificate(cert *x509.Certificate, ca *x509.CertPool) bool {
+	_, err := cert.Verify(x509.VerifyOptions{
+		Roots:     ca,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+
+	return err == nil
+}
+
+func (a *serverAuthenticator) findToken(token string) (string, bool) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	for t, username := range a.tokens {
+		if subtle.ConstantTimeCompare([]byte(t), []byte(token)) == 1 {
+			return username, true
+		}
+	}
+
+	return "", false
+}
+
+func (a *serverAuthenticator) authenticate(ctx context.Context) (context.Context, error) {
+	var c *caller
+
+	// Unix sockets are always authenticated using the peer credentials
+	if p, ok := peer.FromContext(ctx); ok {
+		if info, ok := p.AuthInfo.(peerCredentialsAuthInfo); ok {
+			c, err := a.authenticatePeerCredentials(info)
+			if err != nil {
+				return nil, err
+			}
+
+			auditCaller(ctx, c.Username)
+
+			return context.WithValue(ctx, callerKey{}, c), nil
+		}
+	}
+
+	switch a.mode {
+	case AuthNone:
+		c = &caller{}
+
+	case AuthToken:
+		md, _ := metadata.FromIncomingContext(ctx)
+		values := md.Get(tokenMetadataKey)
+		if len(values) != 1 || !strings.HasPrefix(values[0], tokenPrefix) {
+			return nil, status.Error(codes.Unauthenticated, "missing token")
+		}
+
+		token := strings.TrimPrefix(values[0], tokenPrefix)
+
+		username, ok := a.findToken(token)
+		if !ok {
+			err := a.reloadTokens()
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+
+			username, ok = a.findToken(token)
+		}
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "invalid token")
+		}
+
+		c = &caller{Username: username}
+
+	case AuthTLS:
+		p, ok := peer.FromContext(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "missing peer information")
+		}
+
+		info, ok := p.AuthInfo.(credentials.TLSInfo)
+		if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+			return nil, status.Error(codes.Unauthenticated, "missing client certificate")
+		}
+
+		c = &caller{Username: info.State.VerifiedChains[0][0].Subject.CommonName}
+	}
+
+	auditCaller(ctx, c.Username)
+
+	return context.WithValue(ctx, callerKey{}, c), nil
+}
+
+// authenticatePeerCredentials allows only root and the users enabled by EnableSnapshotsForUser.
+func (a *serverAuthenticator) authenticatePeerCredentials(info peerCredentialsAuthInfo) (*caller, error) {
+	username, err := info.Username()
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unknown user id %v", info.UID)
+	}
+
+	if info.UID != 0 && !fileExists(userCredentialsDir(a.credentialsDir, username)) {
+		return nil, status.Errorf(codes.PermissionDenied, "user %v is not enabled to create snapshots", username)
+	}
+
+	return &caller{Username: username}, nil
+}
+
+func (a *serverAuthenticator) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	// Supervisors and load balancers don't have credentials
+	if isHealthCheck(info.FullMethod) {
+		return handler(ctx, req)
+	}
+
+	ctx, err := a.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func (a *serverAuthenticator) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if isHealthCheck(info.FullMethod) {
+		return handler(srv, ss)
+	}
+
+	ctx, err := a.authenticate(ss.Context())
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &contextServerStream{ServerStream: ss, ctx: ctx})
+}
+
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// clientDialOptions returns the options needed to connect to a server using the configured authentication.
+// Unix sockets don't need any, because the server uses the peer credentials.
+func clientDialOptions(mode AuthMode, credentialsDir string, socket string) ([]grpc.DialOption, error) {
+	if socket != "" {
+		return []grpc.DialOption{
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		}, nil
+	}
+
+	u, err := user.Current()
+	if err != nil {
+		return nil, err
+	}
+
+	dir := userCredentialsDir(credentialsDir, u.Username)
+
+	if mode == AuthDefault {
+		if fileExists(filepath.Join(dir, "client.pem")) {
+			mode = AuthTLS
+		} else {
+			mode = AuthNone
+		}
+	}
+
+	switch mode {
+	case AuthToken:
+		data, err := os.ReadFile(filepath.Join(dir, "token"))
+		if err != nil {
+			return nil, errors.Wrap(err, "error reading token")
+		}
+
+		return []grpc.DialOption{
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithPerRPCCredentials(tokenCredentials(strings.TrimSpace(string(data)))),
+		}, nil
+
+	case AuthTLS:
+		cert, err := tls.LoadX509KeyPair(filepath.Join(dir, "client.pem"), filepath.Join(dir, "client-key.pem"))
+		if err != nil {
+			return nil, errors.Wrap(err, "error loading client certificate")
+		}
+
+		ca, err := loadCertPool(caCertFile(credentialsDir))
+		if err != nil {
+			return nil, err
+		}
+
+		return []grpc.DialOption{
+			grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+				Certificates: []tls.Certificate{cert},
+				RootCAs:      ca,
+				ServerName:   serverCertName,
+				MinVersion:   tls.VersionTLS12,
+			})),
+		}, nil
+
+	default:
+		return []grpc.DialOption{
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		}, nil
+	}
+}
+
+type tokenCredentials string
+
+func (t tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{
+		tokenMetadataKey: tokenPrefix + string(t),
+	}, nil
+}
+
+func (t tokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+func loadCertPool(file string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, errors.Wrap(err, "error loading CA certificate")
+	}
+
+	result := x509.NewCertPool()
+	if !result.AppendCertsFromPEM(data) {
+		return nil, errors.Errorf("invalid CA certificate: %v", file)
+	}
+
+	return result, nil
+}
+
+// createCredentialsForUser creates the CA and server certificates (if needed) and the client certificate
+// and token for the user.
+func createCredentialsForUser(username string, dir string, infoCb InfoMessageCallback) error {
+	u, err := user.Lookup(username)
+	if err != nil {
+		return err
+	}
+
+	private := privateDir(dir)
+
+	err = createSecureDir(dir, nil, false)
+	if err != nil {
+		return err
+	}
+
+	err = createSecureDir(private, nil, true)
+	if err != nil {
+		return err
+	}
+
+	err = createSecureDir(usersDir(dir), nil, false)
+	if err != nil {
+		return err
+	}
+
+	caKeyFile := filepath.Join(private, "ca-key.pem")
+
+	if !fileExists(caCertFile(dir)) || !fileExists(caKeyFile) {
+		infoCb(InfoLevel, "Creating CA certificate in %v", caCertFile(dir))
+
+		err = createCertificate("fs_snapshot CA", true, nil, nil, caCertFile(dir), caKeyFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	ca, caKey, err := loadCertificate(caCertFile(dir), caKeyFile)
+	if err != nil {
+		return err
+	}
+
+	serverCert := filepath.Join(private, "server.pem")
+	if !fileExists(serverCert) {
+		infoCb(InfoLevel, "Creating server certificate in %v", serverCert)
+
+		err = createCertificate(serverCertName, false, ca, caKey, serverCert, filepath.Join(private, "server-key.pem"))
+		if err != nil {
+			return err
+		}
+	}
+
+	ud := userCredentialsDir(dir, u.Username)
+
+	err = createSecureDir(ud, u, true)
+	if err != nil {
+		return err
+	}
+
+	infoCb(InfoLevel, "Creating client certificate and token for user %v in %v", u.Username, ud)
+
+	err = createCertificate(u.Username, false, ca, caKey, filepath.Join(ud, "client.pem"), filepath.Join(ud, "client-key.pem"))
+	if err != nil {
+		return err
+	}
+
+	token := make([]byte, 32)
+	_, err = rand.Read(token)
+	if err != nil {
+		return err
+	}
+
+	err = writeSecureFile(filepath.Join(ud, "token"), []byte(hex.EncodeToString(token)), u)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range []string{"client.pem", "client-key.pem"} {
+		err = setFileOwner(filepath.Join(ud, f), u)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func createCertificate(name string, isCA bool, parent *x509.Certificate, parentKey *ecdsa.PrivateKey,
+	certFile string, keyFile string) error {
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			CommonName: name,
+		},
+		NotBefore:   now.Add(-time.Hour),
+		NotAfter:    now.AddDate(10, 0, 0),
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	if isCA {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+		template.ExtKeyUsage = nil
+
+		parent = template
+		parentKey = key
+
+	} else if name == serverCertName {
+		template.DNSNames = []string{serverCertName}
+		template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		return err
+	}
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return err
+	}
+
+	err = writeSecureFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), nil)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o644)
+}
+
+func loadCertificateFile(certFile string) (*x509.Certificate, error) {
+	data, err := os.ReadFile(certFile)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.Errorf("invalid certificate: %v", certFile)
+	}
+
+	return x509.ParseCertificate(block.Bytes)
+}
+
+func loadCertificate(certFile string, keyFile string) (*x509.Certificate, *ecdsa.PrivateKey, error) {
+	pair, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cert, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key, ok := pair.PrivateKey.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, nil, errors.Errorf("unknown key type in %v", keyFile)
+	}
+
+	return cert, key, nil
+}
+
+func writeSecureFile(path string, data []byte, owner *user.User) error {
+	err := os.WriteFile(path, data, 0o600)
+	if err != nil {
+		return err
+	}
+
+	return setFileOwner(path, owner)
+}
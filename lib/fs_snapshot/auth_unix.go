@@ -0,0 +1,51 @@
+//go:build !windows
+
+package fs_snapshot
+
+import (
+	"os"
+	"os/user"
+	"strconv"
+)
+
+// DefaultCredentialsDir is where EnableSnapshotsForUser stores the certificates and tokens.
+func DefaultCredentialsDir() string {
+	return "/etc/fs_snapshot"
+}
+
+func createSecureDir(path string, owner *user.User, private bool) error {
+	var mode os.FileMode = 0o755
+	if private {
+		mode = 0o700
+	}
+
+	err := os.MkdirAll(path, mode)
+	if err != nil {
+		return err
+	}
+
+	err = os.Chmod(path, mode)
+	if err != nil {
+		return err
+	}
+
+	return setFileOwner(path, owner)
+}
+
+func setFileOwner(path string, owner *user.User) error {
+	if owner == nil {
+		return nil
+	}
+
+	uid, err := strconv.Atoi(owner.Uid)
+	if err != nil {
+		return err
+	}
+
+	gid, err := strconv.Atoi(owner.Gid)
+	if err != nil {
+		return err
+	}
+
+	return os.Chown(path, uid, gid)
+}
@@ -0,0 +1,60 @@
+//go:build windows
+
+package fs_snapshot
+
+import (
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+
+	"golang.org/x/sys/windows"
+)
+
+// DefaultCredentialsDir is where EnableSnapshotsForUser stores the certificates and tokens.
+func DefaultCredentialsDir() string {
+	programData := os.Getenv("ProgramData")
+	if programData == "" {
+		programData = `C:\ProgramData`
+	}
+
+	return filepath.Join(programData, "fs_snapshot")
+}
+
+func createSecureDir(path string, owner *user.User, private bool) error {
+	err := os.MkdirAll(path, 0o755)
+	if err != nil {
+		return err
+	}
+
+	// Always full access to SYSTEM and Administrators
+	sddl := "D:P(A;OICI;FA;;;SY)(A;OICI;FA;;;BA)"
+
+	switch {
+	case owner != nil:
+		// On windows the user ID is the SID
+		sddl += fmt.Sprintf("(A;OICI;FA;;;%v)", owner.Uid)
+	case !private:
+		// Read only for users
+		sddl += "(A;OICI;GRGX;;;BU)"
+	}
+
+	sd, err := windows.SecurityDescriptorFromString(sddl)
+	if err != nil {
+		return err
+	}
+
+	dacl, _, err := sd.DACL()
+	if err != nil {
+		return err
+	}
+
+	return windows.SetNamedSecurityInfo(path, windows.SE_FILE_OBJECT,
+		windows.DACL_SECURITY_INFORMATION|windows.PROTECTED_DACL_SECURITY_INFORMATION,
+		nil, nil, dacl, nil)
+}
+
+func setFileOwner(path string, owner *user.User) error {
+	// Permissions are inherited from the folder
+	return nil
+}
@@ -0,0 +1,105 @@
+package fs_snapshot
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DirectoryStatus is the result of TryToCreateTemporarySnapshot for a directory.
+type DirectoryStatus int
+
+const (
+	// DirectorySnapshoted means the directory is read from a snapshot
+	DirectorySnapshoted DirectoryStatus = iota
+	// DirectoryFallback means the original directory is used instead of a snapshot
+	DirectoryFallback
+	// DirectorySkipped means the directory must not be backed up (see FailurePolicySkip)
+	DirectorySkipped
+	// DirectoryAborted means the backup must be aborted (see FailurePolicyAbort)
+	DirectoryAborted
+)
+
+func (s DirectoryStatus) String() string {
+	switch s {
+	case DirectorySnapshoted:
+		return "snapshot"
+	case DirectoryFallback:
+		return "fallback"
+	case DirectorySkipped:
+		return "skipped"
+	case DirectoryAborted:
+		return "aborted"
+	default:
+		return "unknown"
+	}
+}
+
+// BackupReport has what a Backuper did for each directory requested, in the order they were requested.
+type BackupReport struct {
+	Directories []*DirectoryReport
+}
+
+type DirectoryReport struct {
+	// Directory is the one passed to TryToCreateTemporarySnapshot
+	Directory string
+	// MountPoint that contains the directory. Empty if it is not known.
+	MountPoint string
+	Status     DirectoryStatus
+	// Path to read the data from: inside the snapshot or the original directory. Empty if skipped or aborted.
+	Path string
+	// Snapshot is nil if the directory is not read from a snapshot
+	Snapshot *Snapshot
+	// ProviderID of the snapshot. Empty if there is no snapshot.
+	ProviderID string
+	// FallbackReason says why the directory is not read from a snapshot. Empty if it is.
+	FallbackReason string
+	// Err is the error returned by TryToCreateTemporarySnapshot
+	Err error
+
+	StartTime time.Time
+	Duration  time.Duration
+}
+
+// newDirectoryReport fills the report with the result of TryToCreateTemporarySnapshot. fallbackReason is used
+// instead of the error message if set.
+func newDirectoryReport(directory string, mountPoint string, start time.Time,
+	path string, snapshot *Snapshot, err error, fallbackReason string) *DirectoryReport {
+
+	result := &DirectoryReport{
+		Directory:  directory,
+		MountPoint: mountPoint,
+		Path:       path,
+		Snapshot:   snapshot,
+		Err:        err,
+		StartTime:  start,
+		Duration:   time.Since(start),
+	}
+
+	switch {
+	case snapshot != nil && err == nil:
+		result.Status = DirectorySnapshoted
+		if snapshot.Provider != nil {
+			result.ProviderID = snapshot.Provider.ID
+		}
+		return result
+
+	case errors.Is(err, ErrDirectorySkipped):
+		result.Status = DirectorySkipped
+	case errors.Is(err, ErrBackupAborted):
+		result.Status = DirectoryAborted
+	default:
+		result.Status = DirectoryFallback
+	}
+
+	switch {
+	case fallbackReason != "":
+		result.FallbackReason = fallbackReason
+	case err != nil:
+		result.FallbackReason = err.Error()
+	default:
+		result.FallbackReason = "snapshots are not supported"
+	}
+
+	return result
+}
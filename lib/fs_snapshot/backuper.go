@@ -9,8 +9,48 @@ type Backuper interface {
 	// If the directory is inside an existing snapshot, the snapshot is re-used.
 	// An error is returned iff some problem occurred while creating the snapshot, but not
 	// if the directory does not support snapshots.
+	// What is returned together with the error depends on BackupConfig.FailurePolicy.
+	// The directory can also be a regular file, and then the path of the file inside the snapshot is returned.
+	// Symbolic links (and junctions on Windows) are resolved before finding the volume, so the returned path
+	// is the real path of the directory inside the snapshot of the volume that really contains it.
+	// ToOriginalPath maps paths inside it back onto the directory as it was passed.
 	TryToCreateTemporarySnapshot(directory string) (string, *Snapshot, error)
 
+	// FS returns the contents of the directory, read from a snapshot if one could be made, or from the
+	// original directory otherwise. Paths are relative to the directory.
+	// It uses TryToCreateTemporarySnapshot, so it has the same behaviour for creating and re-using snapshots.
+	FS(directory string) (FS, error)
+
+	// ToSnapshotPath translates a path to the same path inside the snapshots already created by this backuper.
+	// It resolves symbolic links like TryToCreateTemporarySnapshot, also for paths that do not exist inside a
+	// directory passed to it. It returns false (and the path unchanged) if the path is not inside one of them.
+	// It does not create snapshots.
+	ToSnapshotPath(path string) (string, bool)
+
+	// ToOriginalPath is the inverse of ToSnapshotPath: translates a path inside one of the snapshots created by
+	// this backuper to the original path. If it is inside a directory passed to TryToCreateTemporarySnapshot
+	// through a symbolic link, it is expressed relative to the directory as it was passed, else it is the real
+	// path. It returns false (and the path unchanged) if the path is not inside one.
+	ToOriginalPath(path string) (string, bool)
+
+	// Report returns what was done for each directory passed to TryToCreateTemporarySnapshot (or FS).
+	Report() *BackupReport
+
+	// FailedSnapshots returns the snapshots that became invalid after being created (only detected if
+	// BackupConfig.Monitor is set). Backups that read from them are not reliable.
+	FailedSnapshots() []*SnapshotFailure
+
 	// Close frees all resources.
 	Close()
 }
+
+// RemoteBackuper is a Backuper that creates the snapshots using a server. The snapshots may not be readable by
+// the current user, so their files can also be read through the server.
+type RemoteBackuper interface {
+	Backuper
+
+	// SnapshotFS returns the contents of a snapshot created by this backuper, read by the server.
+	// The root of the file system is the root of the snapshot (the same as snapshot.OriginalDir).
+	// Symbolic links are not followed.
+	SnapshotFS(snapshot *Snapshot) (FS, error)
+}
@@ -1,8 +1,12 @@
 package fs_snapshot
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 )
@@ -10,31 +14,144 @@ import (
 type baseBackuper struct {
 	volumes      *volumeInfos
 	infoCallback InfoMessageCallback
+	freeSpace    *freeSpaceGuard  // nil if not checking
+	monitor      *snapshotMonitor // nil if not monitoring
+
+	failuresMutex sync.Mutex
+	failures      []*SnapshotFailure
+
+	retry         *RetryConfig // nil to try only once
+	failurePolicy FailurePolicy
+	abortMutex    sync.Mutex
+	abortErr      error // Set after FailurePolicyAbort is applied
+
+	reportMutex sync.Mutex
+	report      []*DirectoryReport
+
+	fileSnapshotsMutex sync.RWMutex
+	fileSnapshots      []*Snapshot // Created by createFileSnapshot
+
+	pathAliasesMutex sync.RWMutex
+	pathAliases      []*pathAlias // The paths passed to TryToCreateTemporarySnapshot that are not real paths
 
 	listMountPoints func(volume string) ([]string, error)
-	createSnapshot  func(m *mountPointInfo) (*Snapshot, error)
+	// createSnapshot creates the snapshot of a mount point. dir is the first dir requested inside it.
+	createSnapshot func(m *mountPointInfo, dir string) (*Snapshot, error)
+	// createFileSnapshot is used for files, if set. Otherwise, or if it returns nil, files use the snapshot of their
+	// mount point.
+	createFileSnapshot func(m *mountPointInfo, file string) (*Snapshot, error)
+	// authorizeDir is called, if set, before reusing the snapshot of a mount point for another dir.
+	authorizeDir func(dir string) error
+}
+
+// setPolicies uses the retry and failure policies of the config.
+func (b *baseBackuper) setPolicies(cfg *BackupConfig) {
+	if cfg.Retry != nil {
+		retry := *cfg.Retry
+		retry.setDefaults()
+		b.retry = &retry
+	}
+
+	b.failurePolicy = cfg.FailurePolicy
 }
 
 func (b *baseBackuper) TryToCreateTemporarySnapshot(inputDirectory string) (string, *Snapshot, error) {
-	dir, err := absolutePath(inputDirectory)
+	start := time.Now()
+	var mountPoint, fallbackReason string
+
+	result, snapshot, err := b.tryToCreateTemporarySnapshot(inputDirectory, &mountPoint, &fallbackReason)
+
+	b.reportMutex.Lock()
+	b.report = append(b.report,
+		newDirectoryReport(inputDirectory, mountPoint, start, result, snapshot, err, fallbackReason))
+	b.reportMutex.Unlock()
+
+	return result, snapshot, err
+}
+
+// tryToCreateTemporarySnapshot also returns the mount point of the dir and, if the error message does not
+// explain why the snapshot was not used, the reason for the report.
+func (b *baseBackuper) tryToCreateTemporarySnapshot(inputDirectory string, mountPoint *string,
+	fallbackReason *string) (string, *Snapshot, error) {
+
+	b.abortMutex.Lock()
+	abortErr := b.abortErr
+	b.abortMutex.Unlock()
+
+	if abortErr != nil {
+		return "", nil, abortErr
+	}
+
+	// Symbolic links can point to another volume, and inside a snapshot they would still point to the
+	// original files, so the volume and the snapshot path are found using the real path
+	dir, err := realPath(inputDirectory)
 	if err != nil {
 		return inputDirectory, nil, err
 	}
 
-	dir = addPathSeparatorAsSuffix(dir)
-
 	s, err := os.Stat(dir)
 	if err != nil {
 		return inputDirectory, nil, err
 	}
 
-	if !s.IsDir() {
-		return inputDirectory, nil, errors.New("only able to snapshot directories")
+	b.addPathAlias(inputDirectory, dir)
+
+	isDir := s.IsDir()
+
+	if isDir {
+		dir = addPathSeparatorAsSuffix(dir)
+	} else if !s.Mode().IsRegular() {
+		return inputDirectory, nil, errors.New("only able to snapshot directories and regular files")
+	}
+
+	err = b.addVolume(dir)
+	if err != nil {
+		return b.applyFailurePolicy(inputDirectory, err)
+	}
+
+	m := b.volumes.GetMountPoint(dir)
+	*mountPoint = m.dir
+
+	var snapshot *Snapshot
+	if !isDir && b.createFileSnapshot != nil {
+		snapshot, err = b.createFileSnapshot(m, dir)
+		if err == nil && snapshot != nil {
+			b.addFileSnapshot(snapshot)
+		}
+	}
+	if err == nil && snapshot == nil {
+		snapshot, err = b.getOrCreateSnapshot(m, dir)
+	}
+	if err != nil {
+		if errors.Is(err, ErrSnapshotFailedInPreviousAttempt) {
+			m.mutex.RLock()
+			if m.err != nil {
+				*fallbackReason = fmt.Sprintf("%v: %v", err, m.err)
+			}
+			m.mutex.RUnlock()
+		}
+
+		return b.applyFailurePolicy(inputDirectory, err)
+	}
+	if snapshot == nil {
+		return inputDirectory, nil, nil
 	}
 
-	volume := filepath.VolumeName(dir)
+	newDir, err := changeBaseDir(dir, snapshot.OriginalDir, snapshot.SnapshotDir)
+	if err != nil {
+		return inputDirectory, nil, err
+	}
 
-	err = b.volumes.AddVolume(volume, func(volume string) ([]string, error) {
+	if isDir {
+		newDir = addPathSeparatorAsSuffix(newDir)
+	}
+
+	return newDir, snapshot, nil
+}
+
+// addVolume loads the mount points of the volume of a path.
+func (b *baseBackuper) addVolume(path string) error {
+	return b.volumes.AddVolume(filepath.VolumeName(path), func(volume string) ([]string, error) {
 		mps, err := b.listMountPoints(volume)
 		if err != nil {
 			return nil, err
@@ -46,28 +163,46 @@ func (b *baseBackuper) TryToCreateTemporarySnapshot(inputDirectory string) (stri
 
 		return mps, nil
 	})
+}
+
+// isSameOrInside returns true if path is dir or is inside it, using the case sensitivity of the mount point.
+// The server uses it to check its policy.
+func (b *baseBackuper) isSameOrInside(path, dir string) bool {
+	// If the mount points can't be loaded, the default case sensitivity is used
+	_ = b.addVolume(path)
+
+	_, ok := b.volumes.changeBaseDir(path, dir, dir)
+	return ok
+}
+
+func (b *baseBackuper) FS(directory string) (FS, error) {
+	s, err := os.Stat(directory)
 	if err != nil {
-		return inputDirectory, nil, err
+		return nil, err
 	}
 
-	snapshot, err := b.getOrCreateSnapshot(dir)
-	if err != nil {
-		return inputDirectory, nil, err
+	if !s.IsDir() {
+		return nil, errors.Errorf("%v is not a directory", directory)
 	}
 
-	newDir, err := changeBaseDir(dir, snapshot.OriginalDir, snapshot.SnapshotDir)
+	_, snapshot, err := b.TryToCreateTemporarySnapshot(directory)
 	if err != nil {
-		return inputDirectory, nil, err
+		return nil, err
 	}
 
-	newDir = addPathSeparatorAsSuffix(newDir)
+	if snapshot == nil {
+		return newLocalFS(directory), nil
+	}
 
-	return newDir, snapshot, nil
-}
+	dir, err := realPath(directory)
+	if err != nil {
+		return nil, err
+	}
 
-func (b *baseBackuper) getOrCreateSnapshot(dir string) (*Snapshot, error) {
-	m := b.volumes.GetMountPoint(dir)
+	return snapshotSubFS(snapshot, dir)
+}
 
+func (b *baseBackuper) getOrCreateSnapshot(m *mountPointInfo, dir string) (*Snapshot, error) {
 	// First use only a read lock to avoid stopping too much
 	m.mutex.RLock()
 
@@ -77,9 +212,13 @@ func (b *baseBackuper) getOrCreateSnapshot(dir string) (*Snapshot, error) {
 
 	switch state {
 	case StateSuccess:
-		return m.snapshot, nil
+		return b.reuseSnapshot(m, dir)
 	case StateFailed:
 		return nil, ErrSnapshotFailedInPreviousAttempt
+	case StateInvalidated:
+		return nil, ErrSnapshotInvalidated
+	case StateNoSnapshot:
+		return nil, nil
 	}
 
 	m.mutex.Lock()
@@ -88,25 +227,290 @@ func (b *baseBackuper) getOrCreateSnapshot(dir string) (*Snapshot, error) {
 	// Because we locked again, someone else may have already done it
 	switch m.state {
 	case StateSuccess:
-		return m.snapshot, nil
+		return b.reuseSnapshot(m, dir)
 	case StateFailed:
 		return nil, ErrSnapshotFailedInPreviousAttempt
+	case StateInvalidated:
+		return nil, ErrSnapshotInvalidated
+	case StateNoSnapshot:
+		return nil, nil
 	}
 
-	snapshot, err := b.createSnapshot(m)
+	if b.freeSpace != nil {
+		err := b.freeSpace.Check(m.dir)
+		if err != nil {
+			m.state = StateFailed
+			m.err = err
+			return nil, err
+		}
+	}
+
+	snapshot, err := b.createSnapshotWithRetries(m, dir)
 	if err != nil {
-		m.state = StateFailed
+		// Transient errors can go away, so the next dir in this mount point tries again
+		if b.retry == nil || !b.retry.isRetryable(err) {
+			m.state = StateFailed
+			m.err = err
+		}
 		return nil, err
 	}
 
+	if snapshot == nil {
+		m.state = StateNoSnapshot
+		return nil, nil
+	}
+
 	m.state = StateSuccess
 	m.snapshot = snapshot
 
 	return m.snapshot, nil
 }
 
-func (b *baseBackuper) ListSnapshotedDirectories() map[string]string {
-	result := make(map[string]string)
+// reuseSnapshot returns the snapshot already created for the mount point, if dir is authorized.
+func (b *baseBackuper) reuseSnapshot(m *mountPointInfo, dir string) (*Snapshot, error) {
+	if b.authorizeDir != nil {
+		err := b.authorizeDir(dir)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m.snapshot, nil
+}
+
+func (b *baseBackuper) createSnapshotWithRetries(m *mountPointInfo, dir string) (*Snapshot, error) {
+	if b.retry == nil {
+		return b.createSnapshot(m, dir)
+	}
+
+	backoff := b.retry.Backoff
+
+	for attempt := 1; ; attempt++ {
+		snapshot, err := b.createSnapshot(m, dir)
+		if err == nil {
+			return snapshot, nil
+		}
+
+		if attempt >= b.retry.Attempts || !b.retry.isRetryable(err) {
+			return nil, err
+		}
+
+		b.infoCallback(InfoLevel, "Error creating snapshot of %v (attempt %v of %v), trying again in %v: %v",
+			m.dir, attempt, b.retry.Attempts, backoff, err)
+
+		time.Sleep(backoff)
+
+		backoff = b.retry.nextBackoff(backoff)
+	}
+}
+
+// applyFailurePolicy returns the result of TryToCreateTemporarySnapshot when creating the snapshot failed.
+func (b *baseBackuper) applyFailurePolicy(inputDirectory string, err error) (string, *Snapshot, error) {
+	switch b.failurePolicy {
+	case FailurePolicySkip:
+		return "", nil, &failurePolicyError{ErrDirectorySkipped, err}
+
+	case FailurePolicyAbort:
+		err = &failurePolicyError{ErrBackupAborted, err}
+
+		b.abortMutex.Lock()
+		if b.abortErr == nil {
+			b.abortErr = err
+		}
+		b.abortMutex.Unlock()
+
+		return "", nil, err
+
+	default:
+		return inputDirectory, nil, err
+	}
+}
+
+func (b *baseBackuper) addFileSnapshot(snapshot *Snapshot) {
+	b.fileSnapshotsMutex.Lock()
+	defer b.fileSnapshotsMutex.Unlock()
+
+	for _, s := range b.fileSnapshots {
+		if s == snapshot {
+			return
+		}
+	}
+
+	b.fileSnapshots = append(b.fileSnapshots, snapshot)
+}
+
+func (b *baseBackuper) ToSnapshotPath(path string) (string, bool) {
+	abs, err := absolutePath(path)
+	if err != nil {
+		return path, false
+	}
+
+	// Uses the real path, like TryToCreateTemporarySnapshot, but the path does not need to exist
+	resolved, err := realPath(abs)
+	if err == nil {
+		abs = resolved
+	} else {
+		abs, _ = b.mapPathAlias(abs, true)
+	}
+
+	b.fileSnapshotsMutex.RLock()
+	for _, s := range b.fileSnapshots {
+		if b.volumes.samePath(abs, s.OriginalDir) {
+			b.fileSnapshotsMutex.RUnlock()
+			return s.SnapshotDir, true
+		}
+	}
+	b.fileSnapshotsMutex.RUnlock()
+
+	m := b.volumes.GetMountPoint(addPathSeparatorAsSuffix(abs))
+	if m == nil {
+		return path, false
+	}
+
+	m.mutex.RLock()
+	state := m.state
+	snapshot := m.snapshot
+	m.mutex.RUnlock()
+
+	if state != StateSuccess {
+		return path, false
+	}
+
+	result, ok := b.volumes.changeBaseDir(abs, snapshot.OriginalDir, snapshot.SnapshotDir)
+	if !ok {
+		return path, false
+	}
+
+	return result, true
+}
+
+func (b *baseBackuper) ToOriginalPath(path string) (string, bool) {
+	path = normalizeDevicePath(path)
+
+	if !filepath.IsAbs(path) && !strings.HasPrefix(path, `\\?\`) {
+		abs, err := absolutePath(path)
+		if err != nil {
+			return path, false
+		}
+		path = abs
+	}
+
+	b.fileSnapshotsMutex.RLock()
+	for _, s := range b.fileSnapshots {
+		if b.volumes.samePath(path, s.SnapshotDir) {
+			b.fileSnapshotsMutex.RUnlock()
+			result, _ := b.mapPathAlias(s.OriginalDir, false)
+			return result, true
+		}
+	}
+	b.fileSnapshotsMutex.RUnlock()
+
+	// With nested mount points, the most specific snapshot is the right one
+	var best *Snapshot
+	for _, s := range b.listCreatedSnapshots() {
+		snapshotDir := normalizeDevicePath(s.SnapshotDir)
+
+		_, inside := b.volumes.changeBaseDir(path, snapshotDir, s.OriginalDir)
+		if inside && (best == nil || len(snapshotDir) > len(normalizeDevicePath(best.SnapshotDir))) {
+			best = s
+		}
+	}
+
+	if best == nil {
+		return path, false
+	}
+
+	result, ok := b.volumes.changeBaseDir(path, normalizeDevicePath(best.SnapshotDir), best.OriginalDir)
+	if !ok {
+		return path, false
+	}
+
+	result, _ = b.mapPathAlias(result, false)
+
+	return result, true
+}
+
+// pathAlias is a path passed to TryToCreateTemporarySnapshot whose real path is different, like a symbolic link.
+type pathAlias struct {
+	path string // Absolute, as passed by the caller
+	real string
+}
+
+// addPathAlias records the path passed by the caller if it is not the real path, so the paths returned by
+// ToOriginalPath are expressed relative to it.
+func (b *baseBackuper) addPathAlias(inputDirectory string, realDir string) {
+	path, err := absolutePath(inputDirectory)
+	if err != nil {
+		return
+	}
+
+	path = filepath.Clean(path)
+	realDir = filepath.Clean(realDir)
+
+	if path == realDir {
+		return
+	}
+
+	b.pathAliasesMutex.Lock()
+	defer b.pathAliasesMutex.Unlock()
+
+	for _, a := range b.pathAliases {
+		if a.path == path && a.real == realDir {
+			return
+		}
+	}
+
+	b.pathAliases = append(b.pathAliases, &pathAlias{path, realDir})
+}
+
+// mapPathAlias maps a path inside the real path of an alias to the same path inside the path passed by the
+// caller, or the inverse if toReal is true. The most specific alias is used. Returns false (and the path
+// unchanged) if it is not inside one.
+func (b *baseBackuper) mapPathAlias(path string, toReal bool) (string, bool) {
+	b.pathAliasesMutex.RLock()
+	defer b.pathAliasesMutex.RUnlock()
+
+	from := func(a *pathAlias) string {
+		if toReal {
+			return a.path
+		}
+		return a.real
+	}
+	to := func(a *pathAlias) string {
+		if toReal {
+			return a.real
+		}
+		return a.path
+	}
+
+	var best *pathAlias
+	for _, a := range b.pathAliases {
+		_, inside := b.volumes.changeBaseDir(path, from(a), to(a))
+		if inside && (best == nil || len(from(a)) > len(from(best))) {
+			best = a
+		}
+	}
+
+	if best == nil {
+		return path, false
+	}
+
+	return b.volumes.changeBaseDir(path, from(best), to(best))
+}
+
+func (b *baseBackuper) Report() *BackupReport {
+	b.reportMutex.Lock()
+	defer b.reportMutex.Unlock()
+
+	return &BackupReport{
+		Directories: append([]*DirectoryReport{}, b.report...),
+	}
+}
+
+// listCreatedSnapshots returns the valid snapshots created by this backuper.
+func (b *baseBackuper) listCreatedSnapshots() []*Snapshot {
+	var result []*Snapshot
+	seen := map[string]bool{}
 
 	b.volumes.mutex.RLock()
 	defer b.volumes.mutex.RUnlock()
@@ -115,13 +519,9 @@ func (b *baseBackuper) ListSnapshotedDirectories() map[string]string {
 		for _, m := range v {
 			m.mutex.RLock()
 
-			switch m.state {
-			case StateSuccess:
-				result[m.dir] = m.snapshot.SnapshotDir
-			case StateFailed:
-				result[m.dir] = m.dir
-			case StatePending:
-				result[m.dir] = ""
+			if m.state == StateSuccess && !seen[m.snapshot.ID] {
+				seen[m.snapshot.ID] = true
+				result = append(result, m.snapshot)
 			}
 
 			m.mutex.RUnlock()
@@ -130,3 +530,47 @@ func (b *baseBackuper) ListSnapshotedDirectories() map[string]string {
 
 	return result
 }
+
+// markFailed marks a snapshot as invalid, so it is not used anymore. It is ignored if it was already marked.
+func (b *baseBackuper) markFailed(snapshot *Snapshot, reason string) {
+	found := false
+
+	b.volumes.mutex.RLock()
+
+	for _, v := range b.volumes.volumes {
+		for _, m := range v {
+			m.mutex.Lock()
+
+			if m.state == StateSuccess && m.snapshot.ID == snapshot.ID {
+				m.state = StateInvalidated
+				found = true
+			}
+
+			m.mutex.Unlock()
+		}
+	}
+
+	b.volumes.mutex.RUnlock()
+
+	if !found {
+		return
+	}
+
+	b.infoCallback(InfoLevel, "ERROR: the snapshot of %v is not valid anymore: %v", snapshot.OriginalDir, reason)
+
+	b.failuresMutex.Lock()
+	defer b.failuresMutex.Unlock()
+
+	b.failures = append(b.failures, &SnapshotFailure{
+		Snapshot: snapshot,
+		Reason:   reason,
+		Time:     time.Now(),
+	})
+}
+
+func (b *baseBackuper) FailedSnapshots() []*SnapshotFailure {
+	b.failuresMutex.Lock()
+	defer b.failuresMutex.Unlock()
+
+	return append([]*SnapshotFailure{}, b.failures...)
+}
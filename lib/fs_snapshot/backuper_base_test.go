@@ -0,0 +1,247 @@
+package fs_snapshot
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+// newTestBackuper has a case-sensitive root and a case-insensitive data mount point with snapshots, a mount point
+// without a snapshot but with a file clone, a mount point whose snapshot failed, and a link to a dir in data.
+func newTestBackuper(t *testing.T) *baseBackuper {
+	b := &baseBackuper{
+		volumes: newTestVolumeInfos(t, map[string]bool{
+			"":       true,
+			"data":   false,
+			"other":  true,
+			"failed": true,
+		}),
+		infoCallback: func(level MessageLevel, format string, a ...interface{}) {},
+	}
+
+	setState := func(dir string, state mountPointState, snapshot *Snapshot) {
+		m := b.volumes.GetMountPoint(addPathSeparatorAsSuffix(testPath(dir)))
+		m.state = state
+		m.snapshot = snapshot
+	}
+
+	setState("", StateSuccess, &Snapshot{
+		ID:          "root",
+		OriginalDir: addPathSeparatorAsSuffix(testPath("")),
+		SnapshotDir: addPathSeparatorAsSuffix(testPath("snaps/root")),
+	})
+	setState("data", StateSuccess, &Snapshot{
+		ID:          "data",
+		OriginalDir: addPathSeparatorAsSuffix(testPath("data")),
+		SnapshotDir: addPathSeparatorAsSuffix(testPath("snaps/data")),
+	})
+	setState("other", StateNoSnapshot, nil)
+	setState("failed", StateFailed, nil)
+
+	b.fileSnapshots = append(b.fileSnapshots, &Snapshot{
+		ID:          "clone",
+		OriginalDir: testPath("other/file.txt"),
+		SnapshotDir: testPath("clones/1/file.txt"),
+	})
+
+	b.addPathAlias(testPath("link"), testPath("data/photos"))
+
+	return b
+}
+
+func TestBaseBackuperToSnapshotPath(t *testing.T) {
+	b := newTestBackuper(t)
+
+	tests := []struct {
+		path string
+		want string
+		ok   bool
+	}{
+		{"home/a.txt", "snaps/root/home/a.txt", true},
+		{"data/a.txt", "snaps/data/a.txt", true},
+		{"DATA/Photos/a.txt", "snaps/data/Photos/a.txt", true},
+		{"data", "snaps/data", true},
+		{"other/file.txt", "clones/1/file.txt", true},
+		{"other/FILE.txt", "other/FILE.txt", false},
+		{"other/b.txt", "other/b.txt", false},
+		{"failed/a.txt", "failed/a.txt", false},
+		{"link/a.txt", "snaps/data/photos/a.txt", true},
+		{"link", "snaps/data/photos", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			got, ok := b.ToSnapshotPath(testPath(tt.path))
+			if got != testPath(tt.want) || ok != tt.ok {
+				t.Errorf("ToSnapshotPath(%q) = (%q, %v), want (%q, %v)", tt.path, got, ok, testPath(tt.want), tt.ok)
+			}
+		})
+	}
+}
+
+func TestBaseBackuperToOriginalPath(t *testing.T) {
+	b := newTestBackuper(t)
+
+	tests := []struct {
+		path string
+		want string
+		ok   bool
+	}{
+		{"snaps/root/home/a.txt", "home/a.txt", true},
+		{"snaps/data/a.txt", "data/a.txt", true},
+		{"snaps/data", "data", true},
+		{"snaps/root", "", true},
+		{"clones/1/file.txt", "other/file.txt", true},
+		{"SNAPS/data/a.txt", "SNAPS/data/a.txt", false},
+		{"snaps/other/a.txt", "snaps/other/a.txt", false},
+		{"home/a.txt", "home/a.txt", false},
+		{"snaps/data/photos/a.txt", "link/a.txt", true},
+		{"snaps/data/photos", "link", true},
+		{"snaps/data/photoss", "data/photoss", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			got, ok := b.ToOriginalPath(testPath(tt.path))
+			if got != testPath(tt.want) || ok != tt.ok {
+				t.Errorf("ToOriginalPath(%q) = (%q, %v), want (%q, %v)", tt.path, got, ok, testPath(tt.want), tt.ok)
+			}
+		})
+	}
+}
+
+func TestBaseBackuperSymbolicLinks(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("creating symbolic links needs special permissions on Windows")
+	}
+
+	dir := t.TempDir()
+	target := filepath.Join(dir, "target")
+	link := filepath.Join(dir, "link")
+
+	err := os.MkdirAll(filepath.Join(target, "sub"), 0o755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.Symlink(target, link)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	root := testPath("")
+	snapshotDir := addPathSeparatorAsSuffix(testPath("snaps/root"))
+
+	b := &baseBackuper{
+		volumes:      newVolumeInfos(true),
+		infoCallback: func(level MessageLevel, format string, a ...interface{}) {},
+		listMountPoints: func(volume string) ([]string, error) {
+			return []string{root}, nil
+		},
+		createSnapshot: func(m *mountPointInfo, dir string) (*Snapshot, error) {
+			return &Snapshot{ID: "root", OriginalDir: m.dir, SnapshotDir: snapshotDir}, nil
+		},
+	}
+
+	realDir, err := realPath(target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inSnapshot := filepath.Join(snapshotDir, realDir)
+
+	got, snapshot, err := b.TryToCreateTemporarySnapshot(link)
+	if err != nil || snapshot == nil {
+		t.Fatalf("TryToCreateTemporarySnapshot(%q) = (%q, %v, %v)", link, got, snapshot, err)
+	}
+	if got != addPathSeparatorAsSuffix(inSnapshot) {
+		t.Errorf("TryToCreateTemporarySnapshot(%q) = %q, want %q", link, got, addPathSeparatorAsSuffix(inSnapshot))
+	}
+
+	tests := []struct {
+		name     string
+		original string
+		snapshot string
+	}{
+		{"the link", link, inSnapshot},
+		{"existing dir", filepath.Join(link, "sub"), filepath.Join(inSnapshot, "sub")},
+		{"missing file", filepath.Join(link, "missing.txt"), filepath.Join(inSnapshot, "missing.txt")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := b.ToSnapshotPath(tt.original)
+			if got != tt.snapshot || !ok {
+				t.Errorf("ToSnapshotPath(%q) = (%q, %v), want %q", tt.original, got, ok, tt.snapshot)
+			}
+
+			got, ok = b.ToOriginalPath(tt.snapshot)
+			if got != tt.original || !ok {
+				t.Errorf("ToOriginalPath(%q) = (%q, %v), want %q", tt.snapshot, got, ok, tt.original)
+			}
+		})
+	}
+}
+
+func TestBaseBackuperAuthorizesReusedSnapshots(t *testing.T) {
+	dir := t.TempDir()
+	allowed := filepath.Join(dir, "allowed")
+	denied := filepath.Join(dir, "denied")
+
+	for _, d := range []string{allowed, denied} {
+		err := os.Mkdir(d, 0o755)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	deniedReal, err := realPath(denied)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var authorized []string
+
+	b := &baseBackuper{
+		volumes:      newVolumeInfos(true),
+		infoCallback: func(level MessageLevel, format string, a ...interface{}) {},
+		listMountPoints: func(volume string) ([]string, error) {
+			return []string{volume + string(filepath.Separator)}, nil
+		},
+		createSnapshot: func(m *mountPointInfo, dir string) (*Snapshot, error) {
+			return &Snapshot{ID: "root", OriginalDir: m.dir, SnapshotDir: testPath("snaps/root")}, nil
+		},
+		authorizeDir: func(dir string) error {
+			authorized = append(authorized, dir)
+			if isSameOrInside(nil, filepath.Clean(dir), deniedReal) {
+				return errors.Errorf("%v is not allowed", dir)
+			}
+			return nil
+		},
+	}
+
+	tests := []struct {
+		name           string
+		dir            string
+		wantErr        bool
+		wantAuthorized int
+	}{
+		{"creates the snapshot", allowed, false, 0},
+		{"reuses it for a denied dir", denied, true, 1},
+		{"reuses it for an allowed dir", allowed, false, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, snapshot, err := b.TryToCreateTemporarySnapshot(tt.dir)
+
+			if (err != nil) != tt.wantErr || (snapshot == nil) != tt.wantErr {
+				t.Errorf("TryToCreateTemporarySnapshot(%q) = (%v, %v), want error %v", tt.dir, snapshot, err, tt.wantErr)
+			}
+			if len(authorized) != tt.wantAuthorized {
+				t.Errorf("authorizeDir called for %q, want %v calls", authorized, tt.wantAuthorized)
+			}
+		})
+	}
+}
@@ -2,12 +2,15 @@ package fs_snapshot
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"time"
 
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
-	"github.com/pescuma/go-fs-snapshot/lib/fs_snapshot/internal/rpc"
+	rpc "github.com/pescuma/go-fs-snapshot/lib/fs_snapshot/api/v1"
 )
 
 type clientBackuper struct {
@@ -17,36 +20,113 @@ type clientBackuper struct {
 	backuperId   uint32
 	timeout      time.Duration
 	infoCallback InfoMessageCallback
+	stopLease    chan struct{}
+	canReadFiles bool
+	monitoring   bool
 }
 
-func newClientBackuper(client rpc.FsSnapshotClient, backuperId uint32, caseSensitive bool, timeout time.Duration,
+var _ RemoteBackuper = (*clientBackuper)(nil)
+
+func newClientBackuper(client rpc.FsSnapshotClient, backuperId uint32, timeout time.Duration,
+	leaseTime time.Duration, canReadFiles bool, monitoring bool,
 	listMountPoints func(volume string) ([]string, error),
 	infoCallback InfoMessageCallback,
 ) *clientBackuper {
 
 	result := &clientBackuper{}
-	result.volumes = newVolumeInfos(caseSensitive)
+	// The client reads the same file systems as the server, so it detects the case sensitivity of each mount point
+	result.volumes = newVolumeInfos(defaultCaseSensitive())
 	result.client = client
 	result.backuperId = backuperId
 	result.timeout = timeout
 	result.infoCallback = infoCallback
+	result.baseBackuper.infoCallback = infoCallback
+	result.canReadFiles = canReadFiles
+	result.monitoring = monitoring
 
 	result.baseBackuper.listMountPoints = listMountPoints
 	result.baseBackuper.createSnapshot = result.createSnapshot
+	result.baseBackuper.authorizeDir = result.authorizeDir
+
+	// Older servers don't have leases
+	if leaseTime > 0 {
+		result.stopLease = make(chan struct{})
+		go result.renewLease(leaseTime)
+	}
 
 	return result
 }
 
-func (b *clientBackuper) createSnapshot(m *mountPointInfo) (*Snapshot, error) {
+// renewLease keeps the backuper alive in the server until Close is called.
+func (b *clientBackuper) renewLease(leaseTime time.Duration) {
+	ticker := time.NewTicker(leaseTime / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-b.stopLease:
+			return
+
+		case <-ticker.C:
+			b.infoCallback(TraceLevel, "GRPC Sending server request: RenewBackupLease(%v)", b.backuperId)
+
+			ctx, cancel := context.WithTimeout(context.Background(), leaseTime/3)
+
+			_, err := b.client.RenewBackupLease(ctx, &rpc.RenewBackupLeaseRequest{
+				BackuperId: b.backuperId,
+			})
+			cancel()
+
+			if err != nil {
+				b.infoCallback(TraceLevel, "GRPC error: %v", err.Error())
+
+				if status.Code(err) == codes.NotFound {
+					b.leaseExpired()
+					return
+				}
+
+				b.infoCallback(InfoLevel, "Error renewing the lease of the backup: %v", err)
+			}
+		}
+	}
+}
+
+// leaseExpired is called when the server does not know the backuper anymore, so it already deleted its snapshots.
+func (b *clientBackuper) leaseExpired() {
+	b.infoCallback(InfoLevel, "ERROR: the server closed the backup because its lease expired")
+
+	for _, snapshot := range b.listCreatedSnapshots() {
+		b.markFailed(snapshot, "the backup lease expired and the server deleted the snapshot")
+	}
+}
+
+// createSnapshot sends the requested dir instead of its mount point, so the server can check it against its policy.
+func (b *clientBackuper) createSnapshot(_ *mountPointInfo, dir string) (*Snapshot, error) {
+	return b.requestSnapshot(dir)
+}
+
+// authorizeDir sends every dir to the server, even if the snapshot of its mount point was already created,
+// because the server only allows the dirs in its policy.
+func (b *clientBackuper) authorizeDir(dir string) error {
+	_, err := b.requestSnapshot(dir)
+	return err
+}
+
+// createFileSnapshot sends the file to the server, so providers that snapshot single files can be used.
+func (b *clientBackuper) createFileSnapshot(_ *mountPointInfo, file string) (*Snapshot, error) {
+	return b.requestSnapshot(file)
+}
+
+func (b *clientBackuper) requestSnapshot(dir string) (*Snapshot, error) {
 	b.infoCallback(TraceLevel, "GRPC Sending server request: TryToCreateTemporarySnapshot(%v, \"%v\")",
-		b.backuperId, m.dir)
+		b.backuperId, dir)
 
 	ctx, cancel := context.WithTimeout(context.Background(), b.timeout+time.Minute)
 	defer cancel()
 
 	stream, err := b.client.TryToCreateTemporarySnapshot(ctx, &rpc.TryToCreateTemporarySnapshotRequest{
 		BackuperId: b.backuperId,
-		Dir:        m.dir,
+		Dir:        dir,
 	})
 	if err != nil {
 		b.infoCallback(TraceLevel, "GRPC error: %v", err.Error())
@@ -54,6 +134,7 @@ func (b *clientBackuper) createSnapshot(m *mountPointInfo) (*Snapshot, error) {
 	}
 
 	var snapshot *Snapshot
+	received := false
 
 	for {
 		reply, err := stream.Recv()
@@ -72,19 +153,84 @@ func (b *clientBackuper) createSnapshot(m *mountPointInfo) (*Snapshot, error) {
 			b.infoCallback(MessageLevel(mr.Message.Level), "GRPC "+mr.Message.Message)
 
 		case *rpc.TryToCreateTemporarySnapshotReply_Result:
+			received = true
+
+			// The server did not create a snapshot, but it is not an error
+			if mr.Result.Snapshot == nil {
+				break
+			}
+
 			set := convertSnapshotSetToLocal(mr.Result.Snapshot.Set, false)
 			snapshot = convertSnapshotToLocal(mr.Result.Snapshot, set)
+
+			if b.canReadFiles {
+				snapshot.remote = b
+			}
 		}
 	}
 
-	if snapshot == nil {
+	if !received {
 		return nil, errors.New("GRPC error: missing reply data")
 	}
 
 	return snapshot, nil
 }
 
+func (b *clientBackuper) SnapshotFS(snapshot *Snapshot) (FS, error) {
+	if !b.canReadFiles {
+		return nil, errors.New("the server does not support reading snapshot files, it needs to be updated")
+	}
+
+	if snapshot == nil {
+		return nil, errors.New("missing snapshot")
+	}
+
+	return &clientSnapshotFS{
+		backuper: b,
+		snapshot: snapshot,
+		root:     ".",
+	}, nil
+}
+
+// FailedSnapshots asks the server, that is the one monitoring the snapshots.
+func (b *clientBackuper) FailedSnapshots() []*SnapshotFailure {
+	if !b.monitoring {
+		// Can still have failures if the lease expired
+		return b.baseBackuper.FailedSnapshots()
+	}
+
+	b.infoCallback(TraceLevel, "GRPC Sending server request: ListFailedSnapshots(%v)", b.backuperId)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	reply, err := b.client.ListFailedSnapshots(ctx, &rpc.ListFailedSnapshotsRequest{
+		BackuperId: b.backuperId,
+	})
+	if err != nil {
+		b.infoCallback(TraceLevel, "GRPC error: %v", err.Error())
+
+		// Can't know, so assume the worst
+		return append(b.baseBackuper.FailedSnapshots(), &SnapshotFailure{
+			Reason: fmt.Sprintf("error checking the snapshots in the server: %v", err),
+			Time:   time.Now(),
+		})
+	}
+
+	for _, f := range reply.Failures {
+		set := convertSnapshotSetToLocal(f.Snapshot.Set, false)
+		b.markFailed(convertSnapshotToLocal(f.Snapshot, set), f.Reason)
+	}
+
+	return b.baseBackuper.FailedSnapshots()
+}
+
 func (b *clientBackuper) Close() {
+	if b.stopLease != nil {
+		close(b.stopLease)
+		b.stopLease = nil
+	}
+
 	b.infoCallback(TraceLevel, "GRPC Sending server request: CloseBackup(%v)", b.backuperId)
 
 	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
@@ -0,0 +1,445 @@
+//go:build linux
+
+package fs_snapshot
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+// mountPointClonesDir is the private dir created at the root of a mount point when the state dir is in another
+// file system.
+const mountPointClonesDir = ".fs_snapshot"
+
+// linuxBackuper creates LVM snapshots of the mount points in logical volumes, and mounts them read-only in a
+// private dir. Files in other mount points are cloned into a private dir in the same file system. The clone shares
+// the data with the original file, so it is fast and uses no space until one of them changes.
+type linuxBackuper struct {
+	baseBackuper
+
+	parent     *linuxSnapshoter
+	journal    *journal
+	useLvm     bool
+	useReflink bool
+
+	mutex     sync.Mutex
+	clones    map[string]*Snapshot // by original file
+	entries   []*journalEntry      // In creation order
+	lvmMounts map[string]bool      // by mount point, true if it is in a logical volume
+	cloneDirs map[string]string    // by mount point, where its clones are created
+}
+
+func newLinuxBackuper(parent *linuxSnapshoter, useLvm bool, useReflink bool,
+	infoCallback InfoMessageCallback,
+) *linuxBackuper {
+
+	result := &linuxBackuper{}
+	result.parent = parent
+	result.journal = newJournal()
+	result.useLvm = useLvm
+	result.useReflink = useReflink
+	result.volumes = newVolumeInfos(defaultCaseSensitive())
+	result.infoCallback = infoCallback
+	result.clones = make(map[string]*Snapshot)
+	result.lvmMounts = make(map[string]bool)
+	result.cloneDirs = make(map[string]string)
+
+	result.baseBackuper.listMountPoints = parent.ListMountPoints
+	result.baseBackuper.createSnapshot = result.createSnapshot
+	result.baseBackuper.createFileSnapshot = result.createFileSnapshot
+
+	return result
+}
+
+func (b *linuxBackuper) createSnapshot(m *mountPointInfo, _ string) (*Snapshot, error) {
+	if b.useLvm {
+		mount, lv, err := b.findLvmVolume(m)
+		if err != nil {
+			return nil, err
+		}
+
+		if lv != nil {
+			return b.createLvmSnapshot(m, mount, lv)
+		}
+	}
+
+	if !b.useReflink {
+		return nil, errors.Errorf("%v is not in an LVM logical volume", m.dir)
+	}
+
+	// Reflinks can only snapshot files, so the dirs use the original files
+	return nil, nil
+}
+
+// findLvmVolume returns the logical volume mounted at a mount point, or nil if it is not in one.
+func (b *linuxBackuper) findLvmVolume(m *mountPointInfo) (*mountTableEntry, *lvmVolume, error) {
+	mounts, err := readMountTable()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mount := findMount(mounts, m.dir)
+	if mount == nil || !strings.HasPrefix(mount.Device, "/dev/") {
+		return nil, nil, nil
+	}
+
+	volumes, err := listLvmVolumes(b.infoCallback)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return mount, findLvmVolumeOfDevice(volumes, mount.Device), nil
+}
+
+func (b *linuxBackuper) createLvmSnapshot(m *mountPointInfo, mount *mountTableEntry, lv *lvmVolume) (*Snapshot, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	name, err := newLvmSnapshotName()
+	if err != nil {
+		return nil, err
+	}
+
+	id := lv.VG + "/" + name
+
+	args := []string{"--snapshot", "--name", name}
+	if lv.isThin() {
+		// Thin snapshots use the space of the pool, and are not activated by default
+		args = append(args, "--setactivationskip", "n")
+	} else {
+		size := lv.Size * lvmSnapshotPercent / 100
+		if size > lv.VGFree {
+			size = lv.VGFree
+		}
+		if size <= 0 {
+			return nil, errors.Errorf("volume group %v has no free space for the snapshot", lv.VG)
+		}
+
+		args = append(args, "--size", fmt.Sprintf("%vb", size))
+	}
+	args = append(args, lv.ID())
+
+	b.infoCallback(DetailsLevel, "Creating LVM snapshot %v of %v", id, lv.ID())
+
+	// The name is chosen before creating it, so the cleanup can find it if the process dies
+	snapshotEntry, err := b.journal.Add(journalLvmSnapshot, id)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := runAndReturnOutput(b.infoCallback, "lvcreate", args...)
+	if err != nil {
+		b.removeJournalEntry(snapshotEntry)
+		return nil, errors.Errorf("error creating LVM snapshot: %v", output)
+	}
+
+	b.entries = append(b.entries, snapshotEntry)
+
+	mountsDir := getMountsDir()
+
+	err = createPrivateDir(mountsDir)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotDir, err := os.MkdirTemp(mountsDir, temporaryPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	dirEntry, err := b.journal.Add(journalDir, snapshotDir)
+	if err != nil {
+		_ = syscall.Rmdir(snapshotDir)
+		return nil, err
+	}
+
+	b.entries = append(b.entries, dirEntry)
+
+	options := "ro"
+	if mount.FSType == "xfs" {
+		// XFS refuses to mount a file system with the same UUID of a mounted one
+		options += ",nouuid"
+	}
+
+	b.infoCallback(DetailsLevel, "Mounting snapshot at %v", snapshotDir)
+
+	mountEntry, err := b.journal.Add(journalMount, snapshotDir)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err = runAndReturnOutput(b.infoCallback, "mount", "-t", mount.FSType, "-o", options, "/dev/"+id, snapshotDir)
+	if err != nil {
+		b.removeJournalEntry(mountEntry)
+		return nil, errors.Errorf("error mounting LVM snapshot: %v", output)
+	}
+
+	b.entries = append(b.entries, mountEntry)
+
+	return &Snapshot{
+		ID:           id,
+		OriginalDir:  m.dir,
+		SnapshotDir:  snapshotDir,
+		CreationTime: time.Now(),
+		Provider:     b.parent.newLvmProvider(),
+		State:        "created",
+	}, nil
+}
+
+// extendCowSpace extends a thick snapshot, or the pool of a thin one, using free space of the volume group.
+func (b *linuxBackuper) extendCowSpace(snapshot *Snapshot, size int64) error {
+	vg, name, err := parseLvmSnapshotID(snapshot.ID)
+	if err != nil {
+		return err
+	}
+
+	volumes, err := listLvmVolumes(b.infoCallback)
+	if err != nil {
+		return err
+	}
+
+	v := findLvmVolume(volumes, vg, name)
+	if v == nil {
+		return errors.Errorf("LVM snapshot %v not found", snapshot.ID)
+	}
+
+	target := v.ID()
+	if v.isThin() {
+		target = v.VG + "/" + v.Pool
+	}
+
+	if v.VGFree < size {
+		return errors.Errorf("volume group %v has only %v free", v.VG, FormatSize(v.VGFree))
+	}
+
+	output, err := runAndReturnOutput(b.infoCallback, "lvextend", "--size", fmt.Sprintf("+%vb", size), target)
+	if err != nil {
+		return errors.Errorf("error extending %v: %v", target, output)
+	}
+
+	return nil
+}
+
+// createFileSnapshot clones the file, unless it is in a logical volume, that is snapshoted as a whole.
+func (b *linuxBackuper) createFileSnapshot(m *mountPointInfo, file string) (*Snapshot, error) {
+	if !b.useReflink {
+		return nil, nil
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.useLvm {
+		inLvm, ok := b.lvmMounts[m.dir]
+		if !ok {
+			_, lv, err := b.findLvmVolume(m)
+			if err != nil {
+				return nil, err
+			}
+
+			inLvm = lv != nil
+			b.lvmMounts[m.dir] = inLvm
+		}
+
+		if inLvm {
+			return nil, nil
+		}
+	}
+
+	if snapshot, ok := b.clones[file]; ok {
+		return snapshot, nil
+	}
+
+	cloneDir, err := b.getCloneDir(m)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := os.MkdirTemp(cloneDir, temporaryPrefix)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating folder for the file clone")
+	}
+
+	dirEntry, err := b.journal.Add(journalDir, dir)
+	if err != nil {
+		_ = syscall.Rmdir(dir)
+		return nil, err
+	}
+
+	b.entries = append(b.entries, dirEntry)
+
+	clone := filepath.Join(dir, filepath.Base(file))
+
+	b.infoCallback(DetailsLevel, "Cloning %v to %v", file, clone)
+
+	cloneEntry, err := b.journal.Add(journalFile, clone)
+	if err != nil {
+		return nil, err
+	}
+
+	err = cloneFile(file, clone)
+	if err != nil {
+		b.removeJournalEntry(cloneEntry)
+		return nil, err
+	}
+
+	b.entries = append(b.entries, cloneEntry)
+
+	snapshot := &Snapshot{
+		ID:           filepath.Base(dir),
+		OriginalDir:  file,
+		SnapshotDir:  clone,
+		CreationTime: time.Now(),
+		Provider:     b.parent.newProvider(),
+		State:        "created",
+	}
+
+	b.clones[file] = snapshot
+
+	return snapshot, nil
+}
+
+// getCloneDir returns a private dir in the file system of the mount point, because reflinks can't cross file
+// systems. The clones dir in the state dir is used if it is in the same file system, and else a dir at the root
+// of the mount point, so the clones are never created next to the user files.
+func (b *linuxBackuper) getCloneDir(m *mountPointInfo) (string, error) {
+	if dir, ok := b.cloneDirs[m.dir]; ok {
+		return dir, nil
+	}
+
+	var mountDev unix.Stat_t
+	err := unix.Stat(m.dir, &mountDev)
+	if err != nil {
+		return "", errors.Wrapf(err, "error reading mount point %v", m.dir)
+	}
+
+	dir := getClonesDir()
+
+	var stateDev unix.Stat_t
+	if createPrivateDir(dir) != nil || unix.Stat(dir, &stateDev) != nil || stateDev.Dev != mountDev.Dev {
+		dir = filepath.Join(m.dir, mountPointClonesDir)
+
+		err = createPrivateDir(dir)
+		if err != nil {
+			return "", errors.Wrapf(err, "error creating folder for the file clones in %v", m.dir)
+		}
+	}
+
+	b.cloneDirs[m.dir] = dir
+
+	return dir, nil
+}
+
+// getClonesDir returns where the clones of files in the same file system as the state dir are created.
+func getClonesDir() string {
+	return filepath.Join(getStateDir(), "clones")
+}
+
+// isCloneDir returns true if the dir was created by createFileSnapshot.
+func isCloneDir(dir string) bool {
+	parent := filepath.Dir(filepath.Clean(dir))
+
+	if filepath.Base(parent) != mountPointClonesDir && parent != filepath.Clean(getClonesDir()) {
+		return false
+	}
+
+	return isTemporaryPath(dir, parent)
+}
+
+// cloneFile creates a reflink of a file, with the same permissions and modification time.
+func cloneFile(src string, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	info, err := in.Stat()
+	if err != nil {
+		return err
+	}
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+
+	err = unix.IoctlFileClone(int(out.Fd()), int(in.Fd()))
+	closeErr := out.Close()
+
+	if err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chtimes(dst, info.ModTime(), info.ModTime())
+	}
+
+	if err != nil {
+		_ = os.Remove(dst)
+
+		if errors.Is(err, unix.EOPNOTSUPP) || errors.Is(err, unix.EINVAL) || errors.Is(err, unix.EXDEV) {
+			return errors.Errorf("the file system of %v does not support reflinks", src)
+		}
+
+		return errors.Wrapf(err, "error cloning %v", src)
+	}
+
+	return nil
+}
+
+func (b *linuxBackuper) Close() {
+	b.monitor.Stop()
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	for i := len(b.entries) - 1; i >= 0; i-- {
+		e := b.entries[i]
+
+		var err error
+		switch e.Type {
+		case journalFile:
+			b.infoCallback(DetailsLevel, "Deleting file clone %v", e.Data)
+			err = os.Remove(e.Data)
+		case journalDir:
+			err = syscall.Rmdir(e.Data)
+		case journalMount:
+			b.infoCallback(DetailsLevel, "Unmounting snapshot at %v", e.Data)
+			err = run(b.infoCallback, "umount", e.Data)
+		case journalLvmSnapshot:
+			err = removeLvmSnapshot(b.infoCallback, e.Data)
+		}
+
+		if err != nil {
+			b.infoCallback(InfoLevel, "Error removing %v : %v", e.Data, err)
+		} else {
+			b.removeJournalEntry(e)
+		}
+	}
+
+	// The dirs at the root of the mount points are removed if no other process is using them
+	for _, dir := range b.cloneDirs {
+		if dir != getClonesDir() {
+			_ = syscall.Rmdir(dir)
+		}
+	}
+
+	b.entries = nil
+	b.clones = make(map[string]*Snapshot)
+	b.cloneDirs = make(map[string]string)
+}
+
+func (b *linuxBackuper) removeJournalEntry(e *journalEntry) {
+	err := b.journal.Remove(e)
+	if err != nil {
+		b.infoCallback(InfoLevel, "Error updating journal: %v", err)
+	}
+}
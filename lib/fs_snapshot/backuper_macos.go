@@ -4,7 +4,6 @@ package fs_snapshot
 
 import (
 	"os"
-	"regexp"
 	"syscall"
 
 	"github.com/pkg/errors"
@@ -14,9 +13,10 @@ type macosBackuper struct {
 	baseBackuper
 
 	parent         *macosSnapshoter
-	snapshotDates  []string
-	snapshotDirs   []string
-	snapshotMounts []string
+	journal        *journal
+	snapshotDates  []*journalEntry
+	snapshotDirs   []*journalEntry
+	snapshotMounts []*journalEntry
 	mountPoints    map[string]string
 }
 
@@ -27,7 +27,8 @@ func newMacosBackuper(parent *macosSnapshoter,
 
 	result := &macosBackuper{}
 	result.parent = parent
-	result.volumes = newVolumeInfos(true)
+	result.journal = newJournal()
+	result.volumes = newVolumeInfos(defaultCaseSensitive())
 	result.infoCallback = infoCallback
 	result.mountPoints = mountPoints
 
@@ -37,18 +38,27 @@ func newMacosBackuper(parent *macosSnapshoter,
 	return result
 }
 
-func (b *macosBackuper) createSnapshot(m *mountPointInfo) (*Snapshot, error) {
+func (b *macosBackuper) createSnapshot(m *mountPointInfo, _ string) (*Snapshot, error) {
 	drive := b.mountPoints[m.dir]
 
 	b.infoCallback(DetailsLevel, "Creating local snapshot")
 
+	// The date is only known after creating it. If the process dies before updating the entry, the cleanup finds
+	// the snapshot by the creation time of the entry.
+	dateEntry, err := b.journal.Add(journalTmutilSnapshot, "")
+	if err != nil {
+		return nil, err
+	}
+
 	output, err := runAndReturnOutput(b.infoCallback, "tmutil", "localsnapshot", drive)
 	if err != nil {
+		b.removeJournalEntry(dateEntry)
 		return nil, errors.Errorf("error creating local snapshot: %v", err)
 	}
 
-	re := regexp.MustCompile("Created local snapshot with date: ([0-9-]+)")
-	matches := re.FindStringSubmatch(output)
+	b.snapshotDates = append(b.snapshotDates, dateEntry)
+
+	matches := tmutilCreatedRE.FindStringSubmatch(output)
 	if len(matches) != 2 {
 		return nil, errors.Errorf("unknown tmutil output: %v", output)
 	}
@@ -56,25 +66,49 @@ func (b *macosBackuper) createSnapshot(m *mountPointInfo) (*Snapshot, error) {
 	snapshotDate := matches[1]
 	id := prefix + snapshotDate + suffix
 
-	b.snapshotDates = append(b.snapshotDates, snapshotDate)
+	err = b.journal.Update(dateEntry, snapshotDate)
+	if err != nil {
+		// Without the date in the journal it can't be deleted later
+		_ = run(b.infoCallback, "tmutil", "deletelocalsnapshots", snapshotDate)
+		return nil, err
+	}
 
 	b.infoCallback(DetailsLevel, "Created local snapshot with date %v", snapshotDate)
 
-	snapshotDir, err := os.MkdirTemp(os.TempDir(), "fs_snapshot_")
+	mountsDir := getMountsDir()
+
+	err = createPrivateDir(mountsDir)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotDir, err := os.MkdirTemp(mountsDir, temporaryPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	dirEntry, err := b.journal.Add(journalDir, snapshotDir)
 	if err != nil {
+		_ = syscall.Rmdir(snapshotDir)
 		return nil, err
 	}
 
-	b.snapshotDirs = append(b.snapshotDirs, snapshotDir)
+	b.snapshotDirs = append(b.snapshotDirs, dirEntry)
 
 	b.infoCallback(DetailsLevel, "Mounting snapshot at %v", snapshotDir)
 
+	mountEntry, err := b.journal.Add(journalMount, snapshotDir)
+	if err != nil {
+		return nil, err
+	}
+
 	err = run(b.infoCallback, "mount_apfs", "-o", "rdonly,nobrowse", "-s", id, drive, snapshotDir)
 	if err != nil {
+		_ = b.journal.Remove(mountEntry)
 		return nil, errors.Errorf("error mounting local snapshot: %v", err)
 	}
 
-	b.snapshotMounts = append(b.snapshotMounts, snapshotDir)
+	b.snapshotMounts = append(b.snapshotMounts, mountEntry)
 
 	snapshot, err := b.parent.newSnapshot(id, snapshotDate, m.dir, snapshotDir, nil)
 	if err != nil {
@@ -85,27 +119,42 @@ func (b *macosBackuper) createSnapshot(m *mountPointInfo) (*Snapshot, error) {
 }
 
 func (b *macosBackuper) Close() {
+	b.monitor.Stop()
+
 	for _, m := range b.snapshotMounts {
-		b.infoCallback(DetailsLevel, "Unmounting snapshot at %v", m)
-		err := run(b.infoCallback, "umount", m)
+		b.infoCallback(DetailsLevel, "Unmounting snapshot at %v", m.Data)
+		err := run(b.infoCallback, "umount", m.Data)
 		if err != nil {
-			b.infoCallback(InfoLevel, "Error unmounting %v : %v", m, err)
+			b.infoCallback(InfoLevel, "Error unmounting %v : %v", m.Data, err)
+		} else {
+			b.removeJournalEntry(m)
 		}
 	}
 
 	for _, p := range b.snapshotDirs {
-		b.infoCallback(DetailsLevel, "Deleting snapshot mount folder %v", p)
-		err := syscall.Rmdir(p)
+		b.infoCallback(DetailsLevel, "Deleting snapshot mount folder %v", p.Data)
+		err := syscall.Rmdir(p.Data)
 		if err != nil {
-			b.infoCallback(InfoLevel, "Error removing %v : %v", p, err)
+			b.infoCallback(InfoLevel, "Error removing %v : %v", p.Data, err)
+		} else {
+			b.removeJournalEntry(p)
 		}
 	}
 
 	for _, d := range b.snapshotDates {
-		b.infoCallback(DetailsLevel, "Deleting local snapshot with date %v", d)
-		err := run(b.infoCallback, "tmutil", "deletelocalsnapshots", d)
+		if d.Data == "" {
+			// tmutil returned an unknown output, so it is left in the journal for the cleanup to find by date
+			b.infoCallback(InfoLevel, "Unable to delete the local snapshot created at %v: unknown date",
+				d.CreationTime.Local().Format("2006-01-02 15:04:05"))
+			continue
+		}
+
+		b.infoCallback(DetailsLevel, "Deleting local snapshot with date %v", d.Data)
+		err := run(b.infoCallback, "tmutil", "deletelocalsnapshots", d.Data)
 		if err != nil {
-			b.infoCallback(InfoLevel, "Error deleting local snapshot %v : %v", d, err)
+			b.infoCallback(InfoLevel, "Error deleting local snapshot %v : %v", d.Data, err)
+		} else {
+			b.removeJournalEntry(d)
 		}
 	}
 
@@ -113,3 +162,10 @@ func (b *macosBackuper) Close() {
 	b.snapshotDirs = nil
 	b.snapshotDates = nil
 }
+
+func (b *macosBackuper) removeJournalEntry(e *journalEntry) {
+	err := b.journal.Remove(e)
+	if err != nil {
+		b.infoCallback(InfoLevel, "Error updating journal: %v", err)
+	}
+}
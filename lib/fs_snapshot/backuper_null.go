@@ -1,17 +1,49 @@
 package fs_snapshot
 
+import (
+	"sync"
+	"time"
+)
+
 func newNullBackuper() *nullBackuper {
 	return &nullBackuper{}
 }
 
 type nullBackuper struct {
+	reportMutex sync.Mutex
+	report      []*DirectoryReport
 }
 
 func (b *nullBackuper) TryToCreateTemporarySnapshot(dir string) (string, *Snapshot, error) {
+	b.reportMutex.Lock()
+	b.report = append(b.report, newDirectoryReport(dir, "", time.Now(), dir, nil, nil, ""))
+	b.reportMutex.Unlock()
+
 	return dir, nil, nil
 }
 
-func (b *nullBackuper) ListSnapshotedDirectories() map[string]string {
+func (b *nullBackuper) FS(dir string) (FS, error) {
+	return newLocalFS(dir), nil
+}
+
+func (b *nullBackuper) ToSnapshotPath(path string) (string, bool) {
+	return path, false
+}
+
+func (b *nullBackuper) ToOriginalPath(path string) (string, bool) {
+	return path, false
+}
+
+func (b *nullBackuper) Report() *BackupReport {
+	b.reportMutex.Lock()
+	defer b.reportMutex.Unlock()
+
+	return &BackupReport{
+		Directories: append([]*DirectoryReport{}, b.report...),
+	}
+}
+
+func (b *nullBackuper) FailedSnapshots() []*SnapshotFailure {
 	return nil
 }
 
@@ -17,14 +17,21 @@ type windowsBackuper struct {
 
 	parent     *windowsSnapshoter
 	opts       *internal_windows.SnapshotOptions
-	vssResults []*internal_windows.SnapshotsResult
+	journal    *journal
+	vssResults []*windowsSnapshotResult
+}
+
+type windowsSnapshotResult struct {
+	vss          *internal_windows.SnapshotsResult
+	journalEntry *journalEntry
 }
 
 func newWindowsBackuper(parent *windowsSnapshoter, providerID *ole.GUID, timeout time.Duration, simple bool, infoCallback InfoMessageCallback) *windowsBackuper {
 	result := &windowsBackuper{}
 	result.parent = parent
+	result.journal = newJournal()
 
-	result.volumes = newVolumeInfos(false)
+	result.volumes = newVolumeInfos(defaultCaseSensitive())
 	result.infoCallback = infoCallback
 
 	result.baseBackuper.listMountPoints = parent.ListMountPoints
@@ -42,10 +49,24 @@ func newWindowsBackuper(parent *windowsSnapshoter, providerID *ole.GUID, timeout
 	return result
 }
 
-func (b *windowsBackuper) createSnapshot(m *mountPointInfo) (*Snapshot, error) {
-	vsr, err := internal_windows.CreateSnapshots([]string{m.dir}, b.opts)
+func (b *windowsBackuper) createSnapshot(m *mountPointInfo, _ string) (*Snapshot, error) {
+	// The set ID is only known after starting the snapshot set
+	entry, err := b.journal.Add(journalVssSet, "")
+	if err != nil {
+		return nil, err
+	}
+
+	opts := *b.opts
+	opts.SnapshotSetCallback = func(setID *ole.GUID) error {
+		return b.journal.Update(entry, toGuidString(*setID))
+	}
 
-	b.vssResults = append(b.vssResults, vsr)
+	vsr, err := internal_windows.CreateSnapshots([]string{m.dir}, &opts)
+
+	b.vssResults = append(b.vssResults, &windowsSnapshotResult{
+		vss:          vsr,
+		journalEntry: entry,
+	})
 
 	if err != nil {
 		return nil, err
@@ -74,9 +95,45 @@ func (b *windowsBackuper) createSnapshot(m *mountPointInfo) (*Snapshot, error) {
 	return nil, errors.New("Failed after creating snapshot: original volume not found")
 }
 
+// extendCowSpace increases the maximum size of the diff area used by the snapshot.
+func (b *windowsBackuper) extendCowSpace(snapshot *Snapshot, size int64) error {
+	areas, err := internal_windows.QueryDiffAreasForVolume(addPathSeparatorAsSuffix(snapshot.OriginalDir))
+	if err != nil {
+		return err
+	}
+
+	if len(areas) == 0 {
+		return errors.Errorf("no diff area found for %v", snapshot.OriginalDir)
+	}
+
+	area := areas[0]
+	if area.MaximumSpace < 0 {
+		// No limit
+		return nil
+	}
+
+	_, free, err := getDiskSpace(area.DiffAreaVolume)
+	if err != nil {
+		return err
+	}
+
+	if free < size {
+		return errors.Errorf("%v has only %v free", area.DiffAreaVolume, FormatSize(free))
+	}
+
+	return internal_windows.ChangeDiffAreaMaximumSize(area.Volume, area.DiffAreaVolume, area.MaximumSpace+size)
+}
+
 func (b *windowsBackuper) Close() {
+	b.monitor.Stop()
+
 	for _, r := range b.vssResults {
-		r.Close()
+		r.vss.Close()
+
+		err := b.journal.Remove(r.journalEntry)
+		if err != nil {
+			b.infoCallback(InfoLevel, "Error updating journal: %v", err)
+		}
 	}
 
 	b.vssResults = nil
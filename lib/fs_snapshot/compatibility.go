@@ -0,0 +1,83 @@
+package fs_snapshot
+
+import (
+	"context"
+	"runtime"
+	"strings"
+
+	"google.golang.org/grpc"
+)
+
+// APIVersion is the version of the server API. It only changes when there are incompatible changes.
+const APIVersion = 1
+
+// Optional features of the server, returned by GetServerInfo. Clients must check them before using the
+// RPCs that depend on them.
+const (
+	// FeatureLeases means backupers must be kept alive with RenewBackupLease.
+	FeatureLeases = "leases"
+	// FeatureCleanup means the server supports CleanupTemporarySnapshots.
+	FeatureCleanup = "cleanup"
+	// FeatureSnapshotFiles means the server can read the files inside snapshots for the clients, with
+	// StatSnapshotFile, ReadSnapshotDir and ReadSnapshotFile.
+	FeatureSnapshotFiles = "snapshot-files"
+	// FeatureVolumeUsage means the server supports GetVolumeUsage.
+	FeatureVolumeUsage = "volume-usage"
+	// FeatureFreeSpace means the server checks BackupConfig.FreeSpace before creating snapshots.
+	FeatureFreeSpace = "free-space"
+	// FeatureMonitor means the server checks BackupConfig.Monitor and supports ListFailedSnapshots.
+	FeatureMonitor = "monitor"
+	// FeatureRetry means the server checks BackupConfig.Retry and BackupConfig.FailurePolicy.
+	FeatureRetry = "retry"
+	// FeatureReport means the server supports GetBackupReport.
+	FeatureReport = "report"
+	// FeatureFileSnapshots means TryToCreateTemporarySnapshot accepts files.
+	FeatureFileSnapshots = "file-snapshots"
+)
+
+var serverFeatures = []string{
+	FeatureLeases,
+	FeatureCleanup,
+	FeatureSnapshotFiles,
+	FeatureVolumeUsage,
+	FeatureFreeSpace,
+	FeatureMonitor,
+	FeatureRetry,
+	FeatureReport,
+	FeatureFileSnapshots,
+}
+
+// legacyServiceName is the name of the service before the API was public. Servers still register it so
+// older clients can connect, and clients use it to talk to older servers.
+const legacyServiceName = "rpc.FsSnapshot"
+
+// legacyClientConn sends the requests to the legacy service.
+type legacyClientConn struct {
+	cc grpc.ClientConnInterface
+}
+
+func (c *legacyClientConn) Invoke(ctx context.Context, method string, args interface{}, reply interface{}, opts ...grpc.CallOption) error {
+	return c.cc.Invoke(ctx, toLegacyMethod(method), args, reply, opts...)
+}
+
+func (c *legacyClientConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return c.cc.NewStream(ctx, desc, toLegacyMethod(method), opts...)
+}
+
+// toLegacyMethod converts "/fs_snapshot.v1.FsSnapshot/Method" to "/rpc.FsSnapshot/Method".
+func toLegacyMethod(method string) string {
+	return "/" + legacyServiceName + method[strings.LastIndex(method, "/"):]
+}
+
+// isLegacyStream returns true if the request was sent to the legacy service.
+func isLegacyStream(stream grpc.ServerStream) bool {
+	method, _ := grpc.MethodFromServerStream(stream)
+	return strings.HasPrefix(method, "/"+legacyServiceName+"/")
+}
+
+// defaultCaseSensitive returns if paths are case-sensitive by default in this OS. It is only used when the case
+// sensitivity of a mount point can't be detected (see detectCaseSensitivity).
+func defaultCaseSensitive() bool {
+	// APFS and HFS+ volumes are case-insensitive by default
+	return runtime.GOOS != "windows" && runtime.GOOS != "darwin"
+}
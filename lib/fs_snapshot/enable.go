@@ -6,9 +6,8 @@ import (
 	"time"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 
-	"github.com/pescuma/go-fs-snapshot/lib/fs_snapshot/internal/rpc"
+	rpc "github.com/pescuma/go-fs-snapshot/lib/fs_snapshot/api/v1"
 )
 
 // CurrentUserCanCreateSnapshots returns information if the current user can create snapshots
@@ -56,13 +55,16 @@ func serverCanCreateSnapshots(infoCb InfoMessageCallback) (bool, error) {
 func testServerCanCreateSnapshots(addr string, infoCb InfoMessageCallback) (bool, error) {
 	infoCb(TraceLevel, "GRPC Connecting to server at: %v", addr)
 
+	opts, err := clientDialOptions(AuthDefault, DefaultCredentialsDir(), "")
+	if err != nil {
+		infoCb(TraceLevel, "GRPC error: %v", err.Error())
+		return false, err
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
 
-	conn, err := grpc.DialContext(ctx, addr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithBlock(),
-	)
+	conn, err := grpc.DialContext(ctx, addr, append(opts, grpc.WithBlock())...)
 	if err != nil {
 		infoCb(TraceLevel, "GRPC error: %v", err.Error())
 		return false, err
@@ -86,11 +88,21 @@ func testServerCanCreateSnapshots(addr string, infoCb InfoMessageCallback) (bool
 }
 
 // EnableSnapshotsForUser enables the current user to run snapshots.
+// It also creates the certificates and token the user needs to authenticate with the server, in
+// DefaultCredentialsDir().
 // This generally must be run from a prompt with elevated privileges (root or administrator).
 func EnableSnapshotsForUser(username string, infoCb InfoMessageCallback) error {
 	if infoCb == nil {
 		infoCb = func(level MessageLevel, format string, a ...interface{}) {}
 	}
 
-	return enableSnapshotsForUserForOS(username, infoCb)
+	err := enableSnapshotsForUserForOS(username, infoCb)
+	if err != nil {
+		return err
+	}
+
+	infoCb(OutputLevel, "")
+	infoCb(OutputLevel, "Creating server credentials for user %v", username)
+
+	return createCredentialsForUser(username, DefaultCredentialsDir(), infoCb)
 }
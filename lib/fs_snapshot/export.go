@@ -0,0 +1,328 @@
+package fs_snapshot
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"io"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+)
+
+type ArchiveFormat int
+
+const (
+	// ArchiveTar keeps ownership, modes, extended attributes, symbolic links and hard links (in PAX format).
+	ArchiveTar ArchiveFormat = iota
+	// ArchiveTarZstd is ArchiveTar compressed with zstd.
+	ArchiveTarZstd
+	// ArchiveZip keeps modes and symbolic links. Hard links are stored as copies.
+	ArchiveZip
+)
+
+func ParseArchiveFormat(format string) (ArchiveFormat, error) {
+	switch strings.ToLower(format) {
+	case "", "tar":
+		return ArchiveTar, nil
+	case "tar.zst", "tzst":
+		return ArchiveTarZstd, nil
+	case "zip":
+		return ArchiveZip, nil
+	default:
+		return ArchiveTar, errors.Errorf("unknown archive format: %v", format)
+	}
+}
+
+func (f ArchiveFormat) String() string {
+	switch f {
+	case ArchiveTar:
+		return "tar"
+	case ArchiveTarZstd:
+		return "tar.zst"
+	case ArchiveZip:
+		return "zip"
+	default:
+		return "unknown"
+	}
+}
+
+type ExportConfig struct {
+	Format ArchiveFormat
+
+	InfoCallback InfoMessageCallback
+}
+
+func (cfg *ExportConfig) setDefaults() {
+	if cfg.InfoCallback == nil {
+		cfg.InfoCallback = func(level MessageLevel, format string, a ...interface{}) {}
+	}
+}
+
+// ExportArchive writes an archive with the contents of files (usually from Backuper.FS or Snapshot.FS) to w.
+// The entries are named using their path in originalDir (without the leading separator), so the archive is
+// the same as if the original dir had been archived.
+func ExportArchive(w io.Writer, files FS, originalDir string, cfg *ExportConfig) error {
+	if cfg == nil {
+		cfg = &ExportConfig{}
+	}
+	cfg.setDefaults()
+
+	dir, err := absolutePath(originalDir)
+	if err != nil {
+		return err
+	}
+
+	e := &exporter{
+		files:     files,
+		prefix:    archivePrefix(dir),
+		hardLinks: make(map[hardLinkKey]string),
+		cfg:       cfg,
+	}
+
+	switch cfg.Format {
+	case ArchiveTar:
+		return e.writeTar(w)
+	case ArchiveTarZstd:
+		return e.writeTarZstd(w)
+	case ArchiveZip:
+		return e.writeZip(w)
+	default:
+		return errors.Errorf("unknown archive format: %v", cfg.Format)
+	}
+}
+
+// archivePrefix converts an absolute dir to the name used in the archive: / becomes "", /a/b becomes a/b and
+// C:\a becomes C/a.
+func archivePrefix(dir string) string {
+	volume := filepath.VolumeName(dir)
+
+	result := filepath.ToSlash(dir[len(volume):])
+	result = strings.Trim(result, "/")
+
+	volume = strings.TrimSuffix(filepath.ToSlash(volume), ":")
+	volume = strings.Trim(volume, "/")
+
+	return path.Join(volume, result)
+}
+
+type hardLinkKey struct {
+	device uint64
+	inode  uint64
+}
+
+type exporter struct {
+	files     FS
+	prefix    string
+	hardLinks map[hardLinkKey]string
+	cfg       *ExportConfig
+}
+
+// walk calls cb for each file, with its name in the archive. The root is only included if it has a name.
+func (e *exporter) walk(cb func(name string, file string, info fs.FileInfo) error) error {
+	return fs.WalkDir(e.files, ".", func(file string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		name := path.Join(e.prefix, file)
+		if name == "." {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		return cb(name, file, info)
+	})
+}
+
+func (e *exporter) writeTar(w io.Writer) error {
+	tw := tar.NewWriter(w)
+
+	err := e.walk(func(name string, file string, info fs.FileInfo) error {
+		return e.writeTarEntry(tw, name, file, info)
+	})
+	if err != nil {
+		return err
+	}
+
+	return tw.Close()
+}
+
+func (e *exporter) writeTarEntry(tw *tar.Writer, name string, file string, info fs.FileInfo) error {
+	mode := info.Mode()
+
+	if mode&fs.ModeSocket != 0 {
+		e.cfg.InfoCallback(DetailsLevel, "Ignoring socket: %v", name)
+		return nil
+	}
+
+	link := ""
+	if mode&fs.ModeSymlink != 0 {
+		var err error
+		link, err = e.files.ReadLink(file)
+		if err != nil {
+			return err
+		}
+	}
+
+	hdr, err := tar.FileInfoHeader(info, link)
+	if err != nil {
+		return errors.Wrapf(err, "error archiving %v", name)
+	}
+
+	hdr.Name = name
+	if info.IsDir() {
+		hdr.Name += "/"
+	}
+	hdr.Format = tar.FormatPAX
+	// Only available for local files, and not meaningful for a snapshot
+	hdr.AccessTime = time.Time{}
+	hdr.ChangeTime = time.Time{}
+
+	m, err := ReadFileMetadata(e.files, file, info)
+	if err != nil {
+		return err
+	}
+
+	if m.UID >= 0 {
+		hdr.Uid = m.UID
+		hdr.Uname = m.User
+	}
+	if m.GID >= 0 {
+		hdr.Gid = m.GID
+		hdr.Gname = m.Group
+	}
+
+	for k, v := range m.Xattrs {
+		if hdr.PAXRecords == nil {
+			hdr.PAXRecords = make(map[string]string)
+		}
+		hdr.PAXRecords["SCHILY.xattr."+k] = string(v)
+	}
+
+	if mode.IsRegular() && m.Links > 1 {
+		key := hardLinkKey{m.Device, m.Inode}
+
+		if first, ok := e.hardLinks[key]; ok {
+			hdr.Typeflag = tar.TypeLink
+			hdr.Linkname = first
+			hdr.Size = 0
+		} else {
+			e.hardLinks[key] = name
+		}
+	}
+
+	err = tw.WriteHeader(hdr)
+	if err != nil {
+		return errors.Wrapf(err, "error archiving %v", name)
+	}
+
+	if hdr.Typeflag == tar.TypeReg {
+		return e.copyFile(tw, name, file)
+	}
+
+	return nil
+}
+
+func (e *exporter) writeTarZstd(w io.Writer) error {
+	zw, err := zstd.NewWriter(w)
+	if err != nil {
+		return errors.Wrap(err, "error creating the zstd encoder")
+	}
+
+	err = e.writeTar(zw)
+	if err != nil {
+		_ = zw.Close()
+		return err
+	}
+
+	err = zw.Close()
+	if err != nil {
+		return errors.Wrap(err, "error compressing with zstd")
+	}
+
+	return nil
+}
+
+func (e *exporter) writeZip(w io.Writer) error {
+	e.cfg.InfoCallback(DetailsLevel, "zip archives do not keep ownership, extended attributes and hard links")
+
+	zw := zip.NewWriter(w)
+
+	err := e.walk(func(name string, file string, info fs.FileInfo) error {
+		return e.writeZipEntry(zw, name, file, info)
+	})
+	if err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
+
+func (e *exporter) writeZipEntry(zw *zip.Writer, name string, file string, info fs.FileInfo) error {
+	mode := info.Mode()
+
+	if !mode.IsRegular() && !mode.IsDir() && mode&fs.ModeSymlink == 0 {
+		e.cfg.InfoCallback(DetailsLevel, "Ignoring special file: %v", name)
+		return nil
+	}
+
+	hdr, err := zip.FileInfoHeader(info)
+	if err != nil {
+		return errors.Wrapf(err, "error archiving %v", name)
+	}
+
+	hdr.Name = name
+	if info.IsDir() {
+		hdr.Name += "/"
+	}
+	if mode.IsRegular() {
+		hdr.Method = zip.Deflate
+	}
+
+	fw, err := zw.CreateHeader(hdr)
+	if err != nil {
+		return errors.Wrapf(err, "error archiving %v", name)
+	}
+
+	switch {
+	case mode.IsRegular():
+		return e.copyFile(fw, name, file)
+
+	case mode&fs.ModeSymlink != 0:
+		// Same as Info-ZIP: the contents are the link destination
+		link, err := e.files.ReadLink(file)
+		if err != nil {
+			return err
+		}
+
+		_, err = io.WriteString(fw, link)
+		return err
+
+	default:
+		return nil
+	}
+}
+
+func (e *exporter) copyFile(w io.Writer, name string, file string) error {
+	f, err := e.files.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(w, f)
+	if err != nil {
+		return errors.Wrapf(err, "error archiving %v", name)
+	}
+
+	return nil
+}
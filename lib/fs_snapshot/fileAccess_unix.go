@@ -0,0 +1,100 @@
+//go:build !windows
+
+package fs_snapshot
+
+import (
+	"io/fs"
+	"os/user"
+	"strconv"
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+// fileUser is an user of the server, used to check if the files in the snapshots can be read by it.
+type fileUser struct {
+	uid  int
+	gids []int
+}
+
+func lookupFileUser(username string) (*fileUser, error) {
+	u, err := user.Lookup(username)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error looking up user %v", username)
+	}
+
+	uid, err := strconv.Atoi(u.Uid)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid uid of user %v", username)
+	}
+
+	ids, err := u.GroupIds()
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing groups of user %v", username)
+	}
+
+	result := &fileUser{
+		uid: uid,
+	}
+
+	for _, id := range ids {
+		gid, err := strconv.Atoi(id)
+		if err == nil {
+			result.gids = append(result.gids, gid)
+		}
+	}
+
+	return result, nil
+}
+
+// canTraverse returns true if the user can access the entries of the dir.
+func (u *fileUser) canTraverse(path string, info fs.FileInfo) bool {
+	return u.hasPermission(info, 0o1)
+}
+
+// canRead returns true if the user can read the file, or list the dir.
+func (u *fileUser) canRead(path string, info fs.FileInfo) bool {
+	if info.IsDir() {
+		return u.hasPermission(info, 0o5)
+	}
+
+	return u.hasPermission(info, 0o4)
+}
+
+func (u *fileUser) hasPermission(info fs.FileInfo, perm fs.FileMode) bool {
+	st, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return false
+	}
+
+	return u.allows(int(st.Uid), int(st.Gid), info.Mode(), perm)
+}
+
+// allows checks the permission bits of the owner, the group or the others, the same way the kernel does.
+// POSIX ACLs are not checked, so users that can only access the file through them are denied.
+func (u *fileUser) allows(uid int, gid int, mode fs.FileMode, perm fs.FileMode) bool {
+	if u.uid == 0 {
+		return true
+	}
+
+	bits := mode.Perm()
+
+	switch {
+	case uid == u.uid:
+		bits >>= 6
+	case u.isInGroup(gid):
+		bits >>= 3
+	}
+
+	return bits&perm == perm
+}
+
+func (u *fileUser) isInGroup(gid int) bool {
+	for _, g := range u.gids {
+		if g == gid {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,46 @@
+//go:build !windows
+
+package fs_snapshot
+
+import (
+	"fmt"
+	"io/fs"
+	"testing"
+)
+
+func TestFileUserAllows(t *testing.T) {
+	u := &fileUser{uid: 1000, gids: []int{1000, 50}}
+
+	tests := []struct {
+		uid  int
+		gid  int
+		mode fs.FileMode
+		perm fs.FileMode
+		want bool
+	}{
+		{1000, 1000, 0o600, 0o4, true},
+		{1000, 1000, 0o200, 0o4, false},
+		{1000, 1000, 0o044, 0o4, false},
+		{0, 50, 0o640, 0o4, true},
+		{0, 50, 0o604, 0o4, false},
+		{0, 0, 0o604, 0o4, true},
+		{0, 0, 0o640, 0o4, false},
+		{0, 0, 0o755, 0o5, true},
+		{0, 0, 0o754, 0o5, false},
+		{0, 0, 0o711, 0o1, true},
+		{0, 0, 0o700 | fs.ModeDir, 0o1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%v:%v %v %o", tt.uid, tt.gid, tt.mode, tt.perm), func(t *testing.T) {
+			if got := u.allows(tt.uid, tt.gid, tt.mode, tt.perm); got != tt.want {
+				t.Errorf("allows(%v, %v, %v, %o) = %v, want %v", tt.uid, tt.gid, tt.mode, tt.perm, got, tt.want)
+			}
+		})
+	}
+
+	root := &fileUser{uid: 0}
+	if !root.allows(1000, 1000, 0o000, 0o4) {
+		t.Errorf("root is not allowed to read a file without permissions")
+	}
+}
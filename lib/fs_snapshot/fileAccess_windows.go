@@ -0,0 +1,76 @@
+//go:build windows
+
+package fs_snapshot
+
+import (
+	"io/fs"
+	"os/user"
+	"unsafe"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/windows"
+)
+
+// fileUser is an user of the server, used to check if the files in the snapshots can be read by it.
+type fileUser struct {
+	sid *windows.SID
+}
+
+func lookupFileUser(username string) (*fileUser, error) {
+	u, err := user.Lookup(username)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error looking up user %v", username)
+	}
+
+	// On windows the user ID is the SID
+	sid, err := windows.StringToSid(u.Uid)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid SID of user %v", username)
+	}
+
+	return &fileUser{
+		sid: sid,
+	}, nil
+}
+
+// canTraverse always returns true, because by default all users can bypass traverse checking in Windows.
+func (u *fileUser) canTraverse(path string, info fs.FileInfo) bool {
+	return true
+}
+
+var procGetEffectiveRightsFromAcl = windows.NewLazySystemDLL("advapi32.dll").NewProc("GetEffectiveRightsFromAclW")
+
+// canRead returns true if the ACL of the file allows the user (directly or through its groups) to read the file,
+// or list the dir.
+func (u *fileUser) canRead(path string, info fs.FileInfo) bool {
+	sd, err := windows.GetNamedSecurityInfo(path, windows.SE_FILE_OBJECT, windows.DACL_SECURITY_INFORMATION)
+	if err != nil {
+		return false
+	}
+
+	dacl, _, err := sd.DACL()
+	if err == windows.ERROR_OBJECT_NOT_FOUND || (err == nil && dacl == nil) {
+		// No DACL allows everyone
+		return true
+	}
+	if err != nil {
+		return false
+	}
+
+	trustee := windows.TRUSTEE{
+		TrusteeForm:  windows.TRUSTEE_IS_SID,
+		TrusteeType:  windows.TRUSTEE_IS_USER,
+		TrusteeValue: windows.TrusteeValueFromSID(u.sid),
+	}
+
+	var rights windows.ACCESS_MASK
+
+	r, _, _ := procGetEffectiveRightsFromAcl.Call(uintptr(unsafe.Pointer(dacl)), uintptr(unsafe.Pointer(&trustee)),
+		uintptr(unsafe.Pointer(&rights)))
+	if r != 0 {
+		return false
+	}
+
+	// Same value as FILE_LIST_DIRECTORY
+	return rights&windows.FILE_READ_DATA != 0
+}
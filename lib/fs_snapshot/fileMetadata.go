@@ -0,0 +1,99 @@
+package fs_snapshot
+
+import (
+	"io/fs"
+	"os/user"
+	"strconv"
+	"sync"
+)
+
+// FileMetadata has the information about a file that is not in fs.FileInfo, but is needed to archive it.
+// The fs.FileInfo returned by the FS of snapshots read through the server have it in Sys().
+type FileMetadata struct {
+	// UID and GID are -1 if unknown.
+	UID   int
+	GID   int
+	User  string
+	Group string
+
+	// Device and Inode identify hard links, when Links > 1.
+	Device uint64
+	Inode  uint64
+	Links  uint64
+
+	Xattrs map[string][]byte
+}
+
+// ReadFileMetadata returns the metadata of a file of a FS returned by this package. The info must be the
+// result of Stat or ReadDir of the file.
+func ReadFileMetadata(files fs.FS, name string, info fs.FileInfo) (*FileMetadata, error) {
+	if m, ok := info.Sys().(*FileMetadata); ok {
+		return m, nil
+	}
+
+	if l, ok := files.(*localFS); ok {
+		path, err := l.toPath("stat", name)
+		if err != nil {
+			return nil, err
+		}
+
+		return readLocalFileMetadata(path, info)
+	}
+
+	return &FileMetadata{
+		UID: -1,
+		GID: -1,
+	}, nil
+}
+
+// readLocalFileMetadata returns the metadata of a file in the local disk. The info must be the result of
+// os.Lstat on the path.
+func readLocalFileMetadata(path string, info fs.FileInfo) (*FileMetadata, error) {
+	result := newFileMetadataFromSys(info)
+
+	if result.UID >= 0 {
+		result.User = lookupUserName(result.UID)
+	}
+	if result.GID >= 0 {
+		result.Group = lookupGroupName(result.GID)
+	}
+
+	var err error
+	result.Xattrs, err = readXattrs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+var userNames sync.Map
+var groupNames sync.Map
+
+func lookupUserName(uid int) string {
+	if name, ok := userNames.Load(uid); ok {
+		return name.(string)
+	}
+
+	name := ""
+	if u, err := user.LookupId(strconv.Itoa(uid)); err == nil {
+		name = u.Username
+	}
+
+	userNames.Store(uid, name)
+	return name
+}
+
+func lookupGroupName(gid int) string {
+	if name, ok := groupNames.Load(gid); ok {
+		return name.(string)
+	}
+
+	name := ""
+	if g, err := user.LookupGroupId(strconv.Itoa(gid)); err == nil {
+		name = g.Name
+	}
+
+	groupNames.Store(gid, name)
+	return name
+}
@@ -0,0 +1,26 @@
+//go:build !windows
+
+package fs_snapshot
+
+import (
+	"io/fs"
+	"syscall"
+)
+
+func newFileMetadataFromSys(info fs.FileInfo) *FileMetadata {
+	st, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return &FileMetadata{
+			UID: -1,
+			GID: -1,
+		}
+	}
+
+	return &FileMetadata{
+		UID:    int(st.Uid),
+		GID:    int(st.Gid),
+		Device: uint64(st.Dev),
+		Inode:  uint64(st.Ino),
+		Links:  uint64(st.Nlink),
+	}
+}
@@ -0,0 +1,16 @@
+//go:build windows
+
+package fs_snapshot
+
+import (
+	"io/fs"
+)
+
+// newFileMetadataFromSys only returns the unknown values, because Windows has no uid and gid, and the file
+// index needs an open handle.
+func newFileMetadataFromSys(info fs.FileInfo) *FileMetadata {
+	return &FileMetadata{
+		UID: -1,
+		GID: -1,
+	}
+}
@@ -0,0 +1,252 @@
+package fs_snapshot
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// FreeSpaceConfig configures the checks done in the volume before creating each snapshot, to avoid filling it
+// (or the space reserved for the snapshots, that makes the provider discard them).
+type FreeSpaceConfig struct {
+	// MinFreeSpace is the minimum free space in the volume, in bytes. 0 disables the check.
+	MinFreeSpace int64
+
+	// MinFreePercent is the minimum free space in the volume, as a percentage of its size. 0 disables the check.
+	MinFreePercent float64
+
+	// MaxSnapshotsSize is the maximum space used by all the snapshots of the volume, in bytes. 0 disables the check.
+	// It is only checked if the provider knows the space used by the snapshots (see VolumeUsage).
+	MaxSnapshotsSize int64
+
+	// Action is what is done if one of the limits is exceeded.
+	Action FreeSpaceAction
+
+	// ProtectedSnapshots has the IDs of snapshots that must never be deleted by FreeSpaceDeleteOldest.
+	ProtectedSnapshots []string
+}
+
+type FreeSpaceAction int
+
+const (
+	// FreeSpaceRefuse does not create the snapshot.
+	FreeSpaceRefuse FreeSpaceAction = iota
+	// FreeSpaceDeleteOldest deletes the oldest snapshots of the volume until the limits are met, or refuses to
+	// create the snapshot if that is not possible. Snapshots in use by running backupers and protected snapshots
+	// are never deleted.
+	FreeSpaceDeleteOldest
+)
+
+func ParseFreeSpaceAction(action string) (FreeSpaceAction, error) {
+	switch strings.ToLower(action) {
+	case "", "refuse":
+		return FreeSpaceRefuse, nil
+	case "delete-oldest":
+		return FreeSpaceDeleteOldest, nil
+	default:
+		return FreeSpaceRefuse, errors.Errorf("unknown free space action: %v", action)
+	}
+}
+
+func (a FreeSpaceAction) String() string {
+	switch a {
+	case FreeSpaceRefuse:
+		return "refuse"
+	case FreeSpaceDeleteOldest:
+		return "delete-oldest"
+	default:
+		return "unknown"
+	}
+}
+
+var ErrNotEnoughFreeSpace = errors.New("not enough free space to create a snapshot")
+
+// freeSpaceGuard enforces a FreeSpaceConfig before creating the snapshots of a backuper.
+type freeSpaceGuard struct {
+	cfg          *FreeSpaceConfig
+	snapshoter   Snapshoter
+	infoCallback InfoMessageCallback
+
+	// canDelete is used by the server to only delete the snapshots the user is allowed to. nil allows all.
+	canDelete func(snapshot *Snapshot) bool
+}
+
+// newFreeSpaceGuard returns nil if the config has no FreeSpace.
+func newFreeSpaceGuard(cfg *BackupConfig, snapshoter Snapshoter, infoCallback InfoMessageCallback) *freeSpaceGuard {
+	if cfg.FreeSpace == nil {
+		return nil
+	}
+
+	return &freeSpaceGuard{
+		cfg:          cfg.FreeSpace,
+		snapshoter:   snapshoter,
+		infoCallback: infoCallback,
+		canDelete:    cfg.canDeleteSnapshot,
+	}
+}
+
+// Check returns an error wrapping ErrNotEnoughFreeSpace if a snapshot of the volume should not be created.
+func (g *freeSpaceGuard) Check(volume string) error {
+	usage, err := g.snapshoter.GetVolumeUsage(volume)
+	if err != nil {
+		return errors.Wrapf(err, "error checking free space of %v", volume)
+	}
+
+	problem := g.findProblem(usage)
+	if problem == "" {
+		g.infoCallback(DetailsLevel, "Free space of %v is %v of %v, snapshots use %v",
+			volume, FormatSize(usage.FreeSpace), FormatSize(usage.TotalSize), FormatSize(usage.SnapshotsSize))
+		return nil
+	}
+
+	if g.cfg.Action == FreeSpaceDeleteOldest {
+		candidates, err := g.listDeletableSnapshots(volume)
+		if err != nil {
+			return err
+		}
+
+		for _, snapshot := range candidates {
+			g.infoCallback(InfoLevel, "Deleting snapshot %v of %v (created at %v) because %v",
+				snapshot.ID, snapshot.OriginalDir, snapshot.CreationTime.Local().Format("2006-01-02 15:04:05"), problem)
+
+			_, err = g.snapshoter.DeleteSnapshot(snapshot.ID, false)
+			if err != nil {
+				// Another snapshot may still free enough space, so don't fail the backup
+				g.infoCallback(InfoLevel, "Error deleting snapshot %v to free space: %v", snapshot.ID, err)
+				continue
+			}
+
+			usage, err = g.snapshoter.GetVolumeUsage(volume)
+			if err != nil {
+				return errors.Wrapf(err, "error checking free space of %v", volume)
+			}
+
+			problem = g.findProblem(usage)
+			if problem == "" {
+				return nil
+			}
+		}
+	}
+
+	g.infoCallback(InfoLevel, "Not creating snapshot of %v because %v", volume, problem)
+
+	return errors.Wrapf(ErrNotEnoughFreeSpace, "%v: %v", volume, problem)
+}
+
+// findProblem returns the first limit exceeded, or an empty string if none is.
+func (g *freeSpaceGuard) findProblem(usage *VolumeUsage) string {
+	if g.cfg.MinFreeSpace > 0 && usage.FreeSpace < g.cfg.MinFreeSpace {
+		return fmt.Sprintf("free space (%v) is less than %v",
+			FormatSize(usage.FreeSpace), FormatSize(g.cfg.MinFreeSpace))
+	}
+
+	if g.cfg.MinFreePercent > 0 && usage.TotalSize > 0 {
+		percent := float64(usage.FreeSpace) * 100 / float64(usage.TotalSize)
+		if percent < g.cfg.MinFreePercent {
+			return fmt.Sprintf("free space (%.1f%%) is less than %v%%", percent, g.cfg.MinFreePercent)
+		}
+	}
+
+	if g.cfg.MaxSnapshotsSize > 0 {
+		if usage.SnapshotsSize < 0 {
+			g.infoCallback(DetailsLevel, "Not checking the space used by snapshots of %v because it is unknown",
+				usage.Volume)
+
+		} else if usage.SnapshotsSize > g.cfg.MaxSnapshotsSize {
+			return fmt.Sprintf("snapshots use %v, more than %v",
+				FormatSize(usage.SnapshotsSize), FormatSize(g.cfg.MaxSnapshotsSize))
+		}
+	}
+
+	return ""
+}
+
+// listDeletableSnapshots returns the snapshots of the volume that can be deleted, oldest first.
+func (g *freeSpaceGuard) listDeletableSnapshots(volume string) ([]*Snapshot, error) {
+	snapshots, err := g.snapshoter.ListSnapshots("")
+	if err != nil {
+		return nil, err
+	}
+
+	active, err := listActiveJournalEntries()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*Snapshot
+
+	for _, s := range snapshots {
+		switch {
+		case !samePath(addPathSeparatorAsSuffix(s.OriginalDir), addPathSeparatorAsSuffix(volume)):
+			continue
+
+		case g.isProtected(s):
+			g.infoCallback(TraceLevel, "Not deleting snapshot %v because it is protected", s.ID)
+			continue
+
+		case isTemporarySnapshot(s, active):
+			g.infoCallback(TraceLevel, "Not deleting snapshot %v because it is in use by a backup", s.ID)
+			continue
+
+		case g.canDelete != nil && !g.canDelete(s):
+			g.infoCallback(TraceLevel, "Not deleting snapshot %v because the user is not allowed to", s.ID)
+			continue
+		}
+
+		result = append(result, s)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].CreationTime.Before(result[j].CreationTime)
+	})
+
+	return result, nil
+}
+
+func (g *freeSpaceGuard) isProtected(snapshot *Snapshot) bool {
+	for _, id := range g.cfg.ProtectedSnapshots {
+		if id == snapshot.ID || g.snapshoter.SimplifyID(snapshot.ID) == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isTemporarySnapshot returns true if the snapshot is in one of the journal entries.
+func isTemporarySnapshot(snapshot *Snapshot, entries []*journalEntry) bool {
+	for _, e := range entries {
+		switch e.Type {
+		case journalVssSet:
+			if snapshot.Set != nil && strings.EqualFold(snapshot.Set.ID, e.Data) {
+				return true
+			}
+		case journalLvmSnapshot:
+			if snapshot.ID == e.Data {
+				return true
+			}
+		case journalTmutilSnapshot:
+			// The entry has the snapshot date. Pending entries have no date yet.
+			if e.Data != "" && tmutilSnapshotDate(snapshot.ID) == e.Data {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// tmutilSnapshotDate returns the date of a local snapshot ID (com.apple.TimeMachine.<date>.local), or "" if the
+// ID is not one of them.
+func tmutilSnapshotDate(id string) string {
+	const prefix = "com.apple.TimeMachine."
+	const suffix = ".local"
+
+	if !strings.HasPrefix(id, prefix) || !strings.HasSuffix(id, suffix) || len(id) <= len(prefix)+len(suffix) {
+		return ""
+	}
+
+	return id[len(prefix) : len(id)-len(suffix)]
+}
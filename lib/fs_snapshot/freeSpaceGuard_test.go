@@ -0,0 +1,46 @@
+package fs_snapshot
+
+import "testing"
+
+func TestIsTemporarySnapshot(t *testing.T) {
+	tmutil := &Snapshot{ID: "com.apple.TimeMachine.2022-10-01-120000.local"}
+	vss := &Snapshot{
+		ID:  "{11111111-1111-1111-1111-111111111111}",
+		Set: &SnapshotSet{ID: "{2222AAAA-2222-2222-2222-222222222222}"},
+	}
+
+	lvm := &Snapshot{ID: "vg0/fs_snapshot_123"}
+
+	tests := []struct {
+		name     string
+		snapshot *Snapshot
+		entry    *journalEntry
+		want     bool
+	}{
+		{"tmutil same date", tmutil, &journalEntry{Type: journalTmutilSnapshot, Data: "2022-10-01-120000"}, true},
+		{"tmutil other date", tmutil, &journalEntry{Type: journalTmutilSnapshot, Data: "2022-10-01-120001"}, false},
+		{"tmutil partial date", tmutil, &journalEntry{Type: journalTmutilSnapshot, Data: "2022-10-01"}, false},
+		{"tmutil part of the prefix", tmutil, &journalEntry{Type: journalTmutilSnapshot, Data: "TimeMachine"}, false},
+		{"tmutil whole id", tmutil, &journalEntry{Type: journalTmutilSnapshot, Data: tmutil.ID}, false},
+		{"tmutil pending", tmutil, &journalEntry{Type: journalTmutilSnapshot, Data: ""}, false},
+		{"vss same set", vss, &journalEntry{Type: journalVssSet, Data: "{2222AAAA-2222-2222-2222-222222222222}"}, true},
+		{"vss same set other case", vss, &journalEntry{Type: journalVssSet, Data: "{2222aaaa-2222-2222-2222-222222222222}"}, true},
+		{"vss other set", vss, &journalEntry{Type: journalVssSet, Data: "{33333333-3333-3333-3333-333333333333}"}, false},
+		{"vss snapshot id", vss, &journalEntry{Type: journalVssSet, Data: "{11111111-1111-1111-1111-111111111111}"}, false},
+		{"lvm same snapshot", lvm, &journalEntry{Type: journalLvmSnapshot, Data: "vg0/fs_snapshot_123"}, true},
+		{"lvm other snapshot", lvm, &journalEntry{Type: journalLvmSnapshot, Data: "vg0/fs_snapshot_124"}, false},
+		{"lvm origin volume", lvm, &journalEntry{Type: journalLvmSnapshot, Data: "vg0/root"}, false},
+		{"snapshot without set", &Snapshot{ID: "x"}, &journalEntry{Type: journalVssSet, Data: "x"}, false},
+		{"other entry type", tmutil, &journalEntry{Type: journalMount, Data: "2022-10-01-120000"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := isTemporarySnapshot(tt.snapshot, []*journalEntry{tt.entry})
+			if got != tt.want {
+				t.Errorf("isTemporarySnapshot(%v, %v %q) = %v, want %v",
+					tt.snapshot.ID, tt.entry.Type, tt.entry.Data, got, tt.want)
+			}
+		})
+	}
+}
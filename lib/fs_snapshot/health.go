@@ -0,0 +1,102 @@
+package fs_snapshot
+
+import (
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	rpc "github.com/pescuma/go-fs-snapshot/lib/fs_snapshot/api/v1"
+)
+
+const DefaultHealthCheckInterval = time.Minute
+
+// healthChecker periodically checks if the server can still create snapshots (privileges can be lost and
+// tools can be removed) and reports it using the standard gRPC health service.
+type healthChecker struct {
+	server       *health.Server
+	interval     time.Duration
+	infoCallback InfoMessageCallback
+
+	canCreate int32
+}
+
+func newHealthChecker(interval time.Duration, infoCb InfoMessageCallback) *healthChecker {
+	return &healthChecker{
+		server:       health.NewServer(),
+		interval:     interval,
+		infoCallback: infoCb,
+		canCreate:    -1,
+	}
+}
+
+// Start checks now and then periodically, until the returned function is called.
+func (h *healthChecker) Start() func() {
+	h.check()
+
+	stop := make(chan struct{})
+
+	go func() {
+		ticker := time.NewTicker(h.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				h.check()
+			}
+		}
+	}()
+
+	return func() {
+		close(stop)
+		h.server.Shutdown()
+	}
+}
+
+func (h *healthChecker) check() {
+	// The check logs a lot of details, and it runs all the time
+	can, err := currentUserCanCreateSnapshotsForOS(func(level MessageLevel, format string, a ...interface{}) {
+		h.infoCallback(TraceLevel, format, a...)
+	})
+	if err != nil {
+		h.infoCallback(DetailsLevel, "Error checking if snapshots can be created: %v", err)
+		can = false
+	}
+
+	value := int32(0)
+	if can {
+		value = 1
+	}
+
+	old := atomic.SwapInt32(&h.canCreate, value)
+	if old == value {
+		return
+	}
+
+	status := healthpb.HealthCheckResponse_NOT_SERVING
+	if can {
+		status = healthpb.HealthCheckResponse_SERVING
+	}
+
+	if old != -1 || !can {
+		h.infoCallback(InfoLevel, "Health status changed to %v", status)
+	}
+
+	h.server.SetServingStatus("", status)
+	h.server.SetServingStatus(rpc.FsSnapshot_ServiceDesc.ServiceName, status)
+	h.server.SetServingStatus(legacyServiceName, status)
+}
+
+func (h *healthChecker) CanCreateSnapshots() bool {
+	return atomic.LoadInt32(&h.canCreate) == 1
+}
+
+// isHealthCheck returns true for the health service methods, that don't need authentication.
+func isHealthCheck(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/"+healthpb.Health_ServiceDesc.ServiceName+"/")
+}
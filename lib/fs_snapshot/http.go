@@ -0,0 +1,559 @@
+package fs_snapshot
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	rpc "github.com/pescuma/go-fs-snapshot/lib/fs_snapshot/api/v1"
+)
+
+//go:embed openapi.yaml
+var openAPISpec []byte
+
+// httpGateway exposes the server RPCs as a JSON API. Requests go through the same interceptors used by
+// the gRPC server, so they are authenticated and audited the same way.
+// Streams are sent as server-sent events.
+type httpGateway struct {
+	server             *server
+	unaryInterceptors  []grpc.UnaryServerInterceptor
+	streamInterceptors []grpc.StreamServerInterceptor
+}
+
+const httpPrefix = "/v1/"
+
+var httpJSON = protojson.MarshalOptions{EmitUnpopulated: true}
+
+func newHTTPGateway(s *server, unaryInterceptors []grpc.UnaryServerInterceptor, streamInterceptors []grpc.StreamServerInterceptor) *httpGateway {
+	return &httpGateway{
+		server:             s,
+		unaryInterceptors:  unaryInterceptors,
+		streamInterceptors: streamInterceptors,
+	}
+}
+
+func (g *httpGateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.URL.Path, httpPrefix) {
+		writeHTTPError(w, status.Error(codes.NotFound, "not found"))
+		return
+	}
+
+	path := strings.Split(strings.TrimPrefix(r.URL.Path, httpPrefix), "/")
+	query := r.URL.Query()
+	s := g.server
+
+	switch {
+	case r.Method == http.MethodGet && len(path) == 1 && path[0] == "openapi.yaml":
+		w.Header().Set("Content-Type", "application/yaml")
+		_, _ = w.Write(openAPISpec)
+
+	case r.Method == http.MethodGet && len(path) == 1 && path[0] == "health":
+		// Like the gRPC health service, this does not need authentication
+		code := http.StatusOK
+		reply := &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVING}
+		if !s.health.CanCreateSnapshots() {
+			code = http.StatusServiceUnavailable
+			reply.Status = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+
+		data, _ := httpJSON.Marshal(reply)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		_, _ = w.Write(data)
+
+	case r.Method == http.MethodGet && len(path) == 1 && path[0] == "server-info":
+		req := &rpc.GetServerInfoRequest{}
+		g.unary(w, r, "GetServerInfo", req, func(ctx context.Context) (proto.Message, error) {
+			return s.GetServerInfo(ctx, req)
+		})
+
+	case r.Method == http.MethodGet && len(path) == 1 && path[0] == "can-create-snapshots":
+		req := &rpc.CanCreateSnapshotsRequest{}
+		g.unary(w, r, "CanCreateSnapshots", req, func(ctx context.Context) (proto.Message, error) {
+			return s.CanCreateSnapshots(ctx, req)
+		})
+
+	case r.Method == http.MethodGet && len(path) == 1 && path[0] == "providers":
+		req := &rpc.ListProvidersRequest{FilterId: query.Get("filterId")}
+		g.unary(w, r, "ListProviders", req, func(ctx context.Context) (proto.Message, error) {
+			return s.ListProviders(ctx, req)
+		})
+
+	case r.Method == http.MethodGet && len(path) == 1 && path[0] == "sets":
+		req := &rpc.ListSetsRequest{FilterId: query.Get("filterId")}
+		g.unary(w, r, "ListSets", req, func(ctx context.Context) (proto.Message, error) {
+			return s.ListSets(ctx, req)
+		})
+
+	case r.Method == http.MethodDelete && len(path) >= 2 && path[0] == "sets":
+		req := &rpc.DeleteRequest{Id: strings.Join(path[1:], "/"), Force: parseHTTPBool(query.Get("force"))}
+		g.unary(w, r, "DeleteSet", req, func(ctx context.Context) (proto.Message, error) {
+			return s.DeleteSet(ctx, req)
+		})
+
+	case r.Method == http.MethodGet && len(path) == 1 && path[0] == "snapshots":
+		req := &rpc.ListSnapshotsRequest{FilterId: query.Get("filterId")}
+		g.unary(w, r, "ListSnapshots", req, func(ctx context.Context) (proto.Message, error) {
+			return s.ListSnapshots(ctx, req)
+		})
+
+	case r.Method == http.MethodDelete && len(path) >= 2 && path[0] == "snapshots":
+		req := &rpc.DeleteRequest{Id: strings.Join(path[1:], "/"), Force: parseHTTPBool(query.Get("force"))}
+		g.unary(w, r, "DeleteSnapshot", req, func(ctx context.Context) (proto.Message, error) {
+			return s.DeleteSnapshot(ctx, req)
+		})
+
+	case r.Method == http.MethodGet && len(path) == 1 && path[0] == "simplify-id":
+		req := &rpc.SimplifyIdRequest{Id: query.Get("id")}
+		g.unary(w, r, "SimplifyId", req, func(ctx context.Context) (proto.Message, error) {
+			return s.SimplifyId(ctx, req)
+		})
+
+	case r.Method == http.MethodGet && len(path) == 1 && path[0] == "mount-points":
+		req := &rpc.ListMountPointsRequest{Volume: query.Get("volume")}
+		g.unary(w, r, "ListMountPoints", req, func(ctx context.Context) (proto.Message, error) {
+			return s.ListMountPoints(ctx, req)
+		})
+
+	case r.Method == http.MethodGet && len(path) == 1 && path[0] == "volume-usage":
+		req := &rpc.GetVolumeUsageRequest{Volume: query.Get("volume")}
+		g.unary(w, r, "GetVolumeUsage", req, func(ctx context.Context) (proto.Message, error) {
+			return s.GetVolumeUsage(ctx, req)
+		})
+
+	case r.Method == http.MethodPost && len(path) == 1 && path[0] == "cleanup":
+		req := &rpc.CleanupTemporarySnapshotsRequest{}
+		g.unary(w, r, "CleanupTemporarySnapshots", req, func(ctx context.Context) (proto.Message, error) {
+			return s.CleanupTemporarySnapshots(ctx, req)
+		})
+
+	case r.Method == http.MethodPost && len(path) == 1 && path[0] == "backups":
+		req := &rpc.StartBackupRequest{}
+		if !readHTTPBody(w, r, req) {
+			return
+		}
+
+		g.stream(w, r, "StartBackup", req, func(stream grpc.ServerStream) error {
+			return s.StartBackup(req, &startBackupHTTPStream{stream})
+		})
+
+	case len(path) >= 2 && path[0] == "backups":
+		id, err := strconv.ParseUint(path[1], 10, 32)
+		if err != nil {
+			writeHTTPError(w, status.Errorf(codes.InvalidArgument, "invalid backuper id: %v", path[1]))
+			return
+		}
+
+		switch {
+		case r.Method == http.MethodDelete && len(path) == 2:
+			req := &rpc.CloseBackupRequest{BackuperId: uint32(id)}
+			g.stream(w, r, "CloseBackup", req, func(stream grpc.ServerStream) error {
+				return s.CloseBackup(req, &closeBackupHTTPStream{stream})
+			})
+
+		case r.Method == http.MethodPost && len(path) == 3 && path[2] == "snapshots":
+			req := &rpc.TryToCreateTemporarySnapshotRequest{}
+			if !readHTTPBody(w, r, req) {
+				return
+			}
+			req.BackuperId = uint32(id)
+
+			g.stream(w, r, "TryToCreateTemporarySnapshot", req, func(stream grpc.ServerStream) error {
+				return s.TryToCreateTemporarySnapshot(req, &tryToCreateTemporarySnapshotHTTPStream{stream})
+			})
+
+		case r.Method == http.MethodGet && len(path) == 5 && path[2] == "snapshots" && path[4] == "stat":
+			req := &rpc.StatSnapshotFileRequest{
+				BackuperId: uint32(id),
+				SnapshotId: path[3],
+				Path:       query.Get("path"),
+			}
+			g.unary(w, r, "StatSnapshotFile", req, func(ctx context.Context) (proto.Message, error) {
+				return s.StatSnapshotFile(ctx, req)
+			})
+
+		case r.Method == http.MethodGet && len(path) == 5 && path[2] == "snapshots" && path[4] == "dir":
+			req := &rpc.ReadSnapshotDirRequest{
+				BackuperId: uint32(id),
+				SnapshotId: path[3],
+				Path:       query.Get("path"),
+			}
+			g.stream(w, r, "ReadSnapshotDir", req, func(stream grpc.ServerStream) error {
+				return s.ReadSnapshotDir(req, &readSnapshotDirHTTPStream{stream})
+			})
+
+		case r.Method == http.MethodGet && len(path) == 5 && path[2] == "snapshots" && path[4] == "file":
+			req := &rpc.ReadSnapshotFileRequest{
+				BackuperId: uint32(id),
+				SnapshotId: path[3],
+				Path:       query.Get("path"),
+			}
+			req.Offset, _ = strconv.ParseInt(query.Get("offset"), 10, 64)
+			req.Length, _ = strconv.ParseInt(query.Get("length"), 10, 64)
+
+			g.download(w, r, "ReadSnapshotFile", req, func(stream grpc.ServerStream) error {
+				return s.ReadSnapshotFile(req, &readSnapshotFileHTTPStream{stream})
+			})
+
+		case r.Method == http.MethodPost && len(path) == 3 && path[2] == "lease":
+			req := &rpc.RenewBackupLeaseRequest{BackuperId: uint32(id)}
+			g.unary(w, r, "RenewBackupLease", req, func(ctx context.Context) (proto.Message, error) {
+				return s.RenewBackupLease(ctx, req)
+			})
+
+		case r.Method == http.MethodGet && len(path) == 3 && path[2] == "report":
+			req := &rpc.GetBackupReportRequest{BackuperId: uint32(id)}
+			g.unary(w, r, "GetBackupReport", req, func(ctx context.Context) (proto.Message, error) {
+				return s.GetBackupReport(ctx, req)
+			})
+
+		case r.Method == http.MethodGet && len(path) == 3 && path[2] == "failed-snapshots":
+			req := &rpc.ListFailedSnapshotsRequest{BackuperId: uint32(id)}
+			g.unary(w, r, "ListFailedSnapshots", req, func(ctx context.Context) (proto.Message, error) {
+				return s.ListFailedSnapshots(ctx, req)
+			})
+
+		default:
+			writeHTTPError(w, status.Error(codes.NotFound, "not found"))
+		}
+
+	default:
+		writeHTTPError(w, status.Error(codes.NotFound, "not found"))
+	}
+}
+
+// incomingContext creates a context with the same information the gRPC server would have.
+func (g *httpGateway) incomingContext(r *http.Request) context.Context {
+	ctx := r.Context()
+
+	md := metadata.MD{}
+	if a := r.Header.Get("Authorization"); a != "" {
+		md.Set(tokenMetadataKey, a)
+	}
+	ctx = metadata.NewIncomingContext(ctx, md)
+
+	p := &peer.Peer{}
+	if addr, err := net.ResolveTCPAddr("tcp", r.RemoteAddr); err == nil {
+		p.Addr = addr
+	}
+	if r.TLS != nil {
+		p.AuthInfo = credentials.TLSInfo{
+			State:          *r.TLS,
+			CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.PrivacyAndIntegrity},
+		}
+	}
+	ctx = peer.NewContext(ctx, p)
+
+	return ctx
+}
+
+func fullMethodName(method string) string {
+	return "/" + rpc.FsSnapshot_ServiceDesc.ServiceName + "/" + method
+}
+
+func (g *httpGateway) unary(w http.ResponseWriter, r *http.Request, method string, req proto.Message, call func(ctx context.Context) (proto.Message, error)) {
+	info := &grpc.UnaryServerInfo{
+		Server:     g.server,
+		FullMethod: fullMethodName(method),
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return call(ctx)
+	}
+
+	for i := len(g.unaryInterceptors) - 1; i >= 0; i-- {
+		interceptor := g.unaryInterceptors[i]
+		next := handler
+		handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+			return interceptor(ctx, req, info, next)
+		}
+	}
+
+	reply, err := handler(g.incomingContext(r), req)
+	if err != nil {
+		writeHTTPError(w, err)
+		return
+	}
+
+	data, err := httpJSON.Marshal(reply.(proto.Message))
+	if err != nil {
+		writeHTTPError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
+func (g *httpGateway) stream(w http.ResponseWriter, r *http.Request, method string, req proto.Message, call func(stream grpc.ServerStream) error) {
+	handler := g.streamHandler(method, req, call)
+
+	stream := &sseServerStream{
+		ctx:     g.incomingContext(r),
+		w:       w,
+		request: req,
+	}
+
+	err := handler(g.server, stream)
+
+	if !stream.started {
+		if err != nil {
+			writeHTTPError(w, err)
+		} else {
+			_ = stream.writeEvent("end", []byte("{}"))
+		}
+		return
+	}
+
+	if err != nil {
+		_ = stream.writeEvent("error", httpErrorBody(err))
+	} else {
+		_ = stream.writeEvent("end", []byte("{}"))
+	}
+}
+
+// download sends the data of the stream replies as the response body, instead of as events.
+func (g *httpGateway) download(w http.ResponseWriter, r *http.Request, method string, req proto.Message, call func(stream grpc.ServerStream) error) {
+	handler := g.streamHandler(method, req, call)
+
+	stream := &downloadServerStream{
+		sseServerStream: sseServerStream{
+			ctx:     g.incomingContext(r),
+			w:       w,
+			request: req,
+		},
+	}
+
+	err := handler(g.server, stream)
+
+	if !stream.started {
+		if err != nil {
+			writeHTTPError(w, err)
+		} else {
+			w.Header().Set("Content-Type", "application/octet-stream")
+			w.WriteHeader(http.StatusOK)
+		}
+		return
+	}
+
+	if err != nil {
+		// Too late to send the error, so abort the response to let the client know it is incomplete
+		panic(http.ErrAbortHandler)
+	}
+}
+
+// streamHandler chains the stream interceptors, like the gRPC server does.
+func (g *httpGateway) streamHandler(method string, req proto.Message, call func(stream grpc.ServerStream) error) grpc.StreamHandler {
+	info := &grpc.StreamServerInfo{
+		FullMethod:     fullMethodName(method),
+		IsServerStream: true,
+	}
+
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		// Let the interceptors see the request
+		err := stream.RecvMsg(req)
+		if err != nil {
+			return err
+		}
+
+		return call(stream)
+	}
+
+	for i := len(g.streamInterceptors) - 1; i >= 0; i-- {
+		interceptor := g.streamInterceptors[i]
+		next := handler
+		handler = func(srv interface{}, stream grpc.ServerStream) error {
+			return interceptor(srv, stream, info, next)
+		}
+	}
+
+	return handler
+}
+
+// sseServerStream sends the stream replies as server-sent events. Each reply is sent as a "reply" event,
+// followed by an "end" event or an "error" event.
+type sseServerStream struct {
+	ctx     context.Context
+	w       http.ResponseWriter
+	request proto.Message
+	started bool
+}
+
+func (s *sseServerStream) SetHeader(metadata.MD) error  { return nil }
+func (s *sseServerStream) SendHeader(metadata.MD) error { return nil }
+func (s *sseServerStream) SetTrailer(metadata.MD)       {}
+
+func (s *sseServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *sseServerStream) SendMsg(m interface{}) error {
+	data, err := httpJSON.Marshal(m.(proto.Message))
+	if err != nil {
+		return err
+	}
+
+	return s.writeEvent("reply", data)
+}
+
+func (s *sseServerStream) RecvMsg(m interface{}) error {
+	proto.Merge(m.(proto.Message), s.request)
+	return nil
+}
+
+func (s *sseServerStream) writeEvent(event string, data []byte) error {
+	if !s.started {
+		s.started = true
+
+		h := s.w.Header()
+		h.Set("Content-Type", "text/event-stream")
+		h.Set("Cache-Control", "no-cache")
+		s.w.WriteHeader(http.StatusOK)
+	}
+
+	_, err := io.WriteString(s.w, "event: "+event+"\ndata: "+string(data)+"\n\n")
+	if err != nil {
+		return err
+	}
+
+	if f, ok := s.w.(http.Flusher); ok {
+		f.Flush()
+	}
+
+	return nil
+}
+
+// downloadServerStream writes the data of ReadSnapshotFileReply directly to the response.
+type downloadServerStream struct {
+	sseServerStream
+}
+
+func (s *downloadServerStream) SendMsg(m interface{}) error {
+	if !s.started {
+		s.started = true
+
+		s.w.Header().Set("Content-Type", "application/octet-stream")
+		s.w.WriteHeader(http.StatusOK)
+	}
+
+	_, err := s.w.Write(m.(*rpc.ReadSnapshotFileReply).Data)
+	return err
+}
+
+type startBackupHTTPStream struct {
+	grpc.ServerStream
+}
+
+func (s *startBackupHTTPStream) Send(m *rpc.StartBackupReply) error {
+	return s.SendMsg(m)
+}
+
+type tryToCreateTemporarySnapshotHTTPStream struct {
+	grpc.ServerStream
+}
+
+func (s *tryToCreateTemporarySnapshotHTTPStream) Send(m *rpc.TryToCreateTemporarySnapshotReply) error {
+	return s.SendMsg(m)
+}
+
+type closeBackupHTTPStream struct {
+	grpc.ServerStream
+}
+
+func (s *closeBackupHTTPStream) Send(m *rpc.CloseBackupReply) error {
+	return s.SendMsg(m)
+}
+
+type readSnapshotDirHTTPStream struct {
+	grpc.ServerStream
+}
+
+func (s *readSnapshotDirHTTPStream) Send(m *rpc.ReadSnapshotDirReply) error {
+	return s.SendMsg(m)
+}
+
+type readSnapshotFileHTTPStream struct {
+	grpc.ServerStream
+}
+
+func (s *readSnapshotFileHTTPStream) Send(m *rpc.ReadSnapshotFileReply) error {
+	return s.SendMsg(m)
+}
+
+func readHTTPBody(w http.ResponseWriter, r *http.Request, req proto.Message) bool {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeHTTPError(w, status.Errorf(codes.InvalidArgument, "error reading request: %v", err))
+		return false
+	}
+
+	if len(strings.TrimSpace(string(data))) == 0 {
+		return true
+	}
+
+	err = protojson.Unmarshal(data, req)
+	if err != nil {
+		writeHTTPError(w, status.Errorf(codes.InvalidArgument, "invalid request: %v", err))
+		return false
+	}
+
+	return true
+}
+
+func parseHTTPBool(s string) bool {
+	b, _ := strconv.ParseBool(s)
+	return b
+}
+
+func httpErrorBody(err error) []byte {
+	st, _ := status.FromError(err)
+
+	data, _ := json.Marshal(map[string]string{
+		"code":    st.Code().String(),
+		"message": st.Message(),
+	})
+
+	return data
+}
+
+func writeHTTPError(w http.ResponseWriter, err error) {
+	st, _ := status.FromError(err)
+
+	code := http.StatusInternalServerError
+	switch st.Code() {
+	case codes.InvalidArgument:
+		code = http.StatusBadRequest
+	case codes.Unauthenticated:
+		code = http.StatusUnauthorized
+	case codes.PermissionDenied:
+		code = http.StatusForbidden
+	case codes.NotFound:
+		code = http.StatusNotFound
+	case codes.ResourceExhausted:
+		code = http.StatusTooManyRequests
+	case codes.Unimplemented:
+		code = http.StatusNotImplemented
+	case codes.Unavailable:
+		code = http.StatusServiceUnavailable
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_, _ = w.Write(httpErrorBody(err))
+}
@@ -1,2151 +0,0 @@
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.28.1
-// 	protoc        v3.21.5
-// source: server.proto
-
-package rpc
-
-import (
-	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
-	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	reflect "reflect"
-	sync "sync"
-)
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-type MessageLevel int32
-
-const (
-	MessageLevel_OutputLevel  MessageLevel = 0
-	MessageLevel_InfoLevel    MessageLevel = 1
-	MessageLevel_DetailsLevel MessageLevel = 2
-	MessageLevel_TraceLevel   MessageLevel = 3
-)
-
-// Enum value maps for MessageLevel.
-var (
-	MessageLevel_name = map[int32]string{
-		0: "OutputLevel",
-		1: "InfoLevel",
-		2: "DetailsLevel",
-		3: "TraceLevel",
-	}
-	MessageLevel_value = map[string]int32{
-		"OutputLevel":  0,
-		"InfoLevel":    1,
-		"DetailsLevel": 2,
-		"TraceLevel":   3,
-	}
-)
-
-func (x MessageLevel) Enum() *MessageLevel {
-	p := new(MessageLevel)
-	*p = x
-	return p
-}
-
-func (x MessageLevel) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
-}
-
-func (MessageLevel) Descriptor() protoreflect.EnumDescriptor {
-	return file_server_proto_enumTypes[0].Descriptor()
-}
-
-func (MessageLevel) Type() protoreflect.EnumType {
-	return &file_server_proto_enumTypes[0]
-}
-
-func (x MessageLevel) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
-}
-
-// Deprecated: Use MessageLevel.Descriptor instead.
-func (MessageLevel) EnumDescriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{0}
-}
-
-type CanCreateSnapshotsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *CanCreateSnapshotsRequest) Reset() {
-	*x = CanCreateSnapshotsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *CanCreateSnapshotsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CanCreateSnapshotsRequest) ProtoMessage() {}
-
-func (x *CanCreateSnapshotsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CanCreateSnapshotsRequest.ProtoReflect.Descriptor instead.
-func (*CanCreateSnapshotsRequest) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{0}
-}
-
-type CanCreateSnapshotsReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Can bool `protobuf:"varint,1,opt,name=can,proto3" json:"can,omitempty"`
-}
-
-func (x *CanCreateSnapshotsReply) Reset() {
-	*x = CanCreateSnapshotsReply{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *CanCreateSnapshotsReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CanCreateSnapshotsReply) ProtoMessage() {}
-
-func (x *CanCreateSnapshotsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CanCreateSnapshotsReply.ProtoReflect.Descriptor instead.
-func (*CanCreateSnapshotsReply) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *CanCreateSnapshotsReply) GetCan() bool {
-	if x != nil {
-		return x.Can
-	}
-	return false
-}
-
-type ListProvidersRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	FilterId string `protobuf:"bytes,1,opt,name=filterId,proto3" json:"filterId,omitempty"`
-}
-
-func (x *ListProvidersRequest) Reset() {
-	*x = ListProvidersRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ListProvidersRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListProvidersRequest) ProtoMessage() {}
-
-func (x *ListProvidersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListProvidersRequest.ProtoReflect.Descriptor instead.
-func (*ListProvidersRequest) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{2}
-}
-
-func (x *ListProvidersRequest) GetFilterId() string {
-	if x != nil {
-		return x.FilterId
-	}
-	return ""
-}
-
-type ListProvidersReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Providers []*Provider `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
-}
-
-func (x *ListProvidersReply) Reset() {
-	*x = ListProvidersReply{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ListProvidersReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListProvidersReply) ProtoMessage() {}
-
-func (x *ListProvidersReply) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListProvidersReply.ProtoReflect.Descriptor instead.
-func (*ListProvidersReply) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *ListProvidersReply) GetProviders() []*Provider {
-	if x != nil {
-		return x.Providers
-	}
-	return nil
-}
-
-type ListSetsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	FilterId string `protobuf:"bytes,1,opt,name=filterId,proto3" json:"filterId,omitempty"`
-}
-
-func (x *ListSetsRequest) Reset() {
-	*x = ListSetsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ListSetsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListSetsRequest) ProtoMessage() {}
-
-func (x *ListSetsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListSetsRequest.ProtoReflect.Descriptor instead.
-func (*ListSetsRequest) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *ListSetsRequest) GetFilterId() string {
-	if x != nil {
-		return x.FilterId
-	}
-	return ""
-}
-
-type ListSetsReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Sets []*SnapshotSet `protobuf:"bytes,1,rep,name=sets,proto3" json:"sets,omitempty"`
-}
-
-func (x *ListSetsReply) Reset() {
-	*x = ListSetsReply{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ListSetsReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListSetsReply) ProtoMessage() {}
-
-func (x *ListSetsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListSetsReply.ProtoReflect.Descriptor instead.
-func (*ListSetsReply) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *ListSetsReply) GetSets() []*SnapshotSet {
-	if x != nil {
-		return x.Sets
-	}
-	return nil
-}
-
-type ListSnapshotsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	FilterId string `protobuf:"bytes,1,opt,name=filterId,proto3" json:"filterId,omitempty"`
-}
-
-func (x *ListSnapshotsRequest) Reset() {
-	*x = ListSnapshotsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ListSnapshotsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListSnapshotsRequest) ProtoMessage() {}
-
-func (x *ListSnapshotsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListSnapshotsRequest.ProtoReflect.Descriptor instead.
-func (*ListSnapshotsRequest) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *ListSnapshotsRequest) GetFilterId() string {
-	if x != nil {
-		return x.FilterId
-	}
-	return ""
-}
-
-type ListSnapshotsReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Snapshots []*Snapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
-}
-
-func (x *ListSnapshotsReply) Reset() {
-	*x = ListSnapshotsReply{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ListSnapshotsReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListSnapshotsReply) ProtoMessage() {}
-
-func (x *ListSnapshotsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListSnapshotsReply.ProtoReflect.Descriptor instead.
-func (*ListSnapshotsReply) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *ListSnapshotsReply) GetSnapshots() []*Snapshot {
-	if x != nil {
-		return x.Snapshots
-	}
-	return nil
-}
-
-type SimplifyIdRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-}
-
-func (x *SimplifyIdRequest) Reset() {
-	*x = SimplifyIdRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *SimplifyIdRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SimplifyIdRequest) ProtoMessage() {}
-
-func (x *SimplifyIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SimplifyIdRequest.ProtoReflect.Descriptor instead.
-func (*SimplifyIdRequest) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *SimplifyIdRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-type SimplifyIdReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	SimpleId string `protobuf:"bytes,1,opt,name=simpleId,proto3" json:"simpleId,omitempty"`
-}
-
-func (x *SimplifyIdReply) Reset() {
-	*x = SimplifyIdReply{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *SimplifyIdReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SimplifyIdReply) ProtoMessage() {}
-
-func (x *SimplifyIdReply) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SimplifyIdReply.ProtoReflect.Descriptor instead.
-func (*SimplifyIdReply) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *SimplifyIdReply) GetSimpleId() string {
-	if x != nil {
-		return x.SimpleId
-	}
-	return ""
-}
-
-type DeleteRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Force bool   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
-}
-
-func (x *DeleteRequest) Reset() {
-	*x = DeleteRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *DeleteRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DeleteRequest) ProtoMessage() {}
-
-func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
-func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *DeleteRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *DeleteRequest) GetForce() bool {
-	if x != nil {
-		return x.Force
-	}
-	return false
-}
-
-type DeleteReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Deleted bool `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
-}
-
-func (x *DeleteReply) Reset() {
-	*x = DeleteReply{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *DeleteReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DeleteReply) ProtoMessage() {}
-
-func (x *DeleteReply) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DeleteReply.ProtoReflect.Descriptor instead.
-func (*DeleteReply) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *DeleteReply) GetDeleted() bool {
-	if x != nil {
-		return x.Deleted
-	}
-	return false
-}
-
-type ListMountPointsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Volume string `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
-}
-
-func (x *ListMountPointsRequest) Reset() {
-	*x = ListMountPointsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ListMountPointsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListMountPointsRequest) ProtoMessage() {}
-
-func (x *ListMountPointsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListMountPointsRequest.ProtoReflect.Descriptor instead.
-func (*ListMountPointsRequest) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *ListMountPointsRequest) GetVolume() string {
-	if x != nil {
-		return x.Volume
-	}
-	return ""
-}
-
-type ListMountPointsReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	MountPoints []string `protobuf:"bytes,1,rep,name=mountPoints,proto3" json:"mountPoints,omitempty"`
-}
-
-func (x *ListMountPointsReply) Reset() {
-	*x = ListMountPointsReply{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ListMountPointsReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListMountPointsReply) ProtoMessage() {}
-
-func (x *ListMountPointsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListMountPointsReply.ProtoReflect.Descriptor instead.
-func (*ListMountPointsReply) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *ListMountPointsReply) GetMountPoints() []string {
-	if x != nil {
-		return x.MountPoints
-	}
-	return nil
-}
-
-type StartBackupRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ProviderId   string `protobuf:"bytes,1,opt,name=providerId,proto3" json:"providerId,omitempty"`
-	TimeoutInSec int32  `protobuf:"varint,2,opt,name=timeoutInSec,proto3" json:"timeoutInSec,omitempty"`
-	Simple       bool   `protobuf:"varint,3,opt,name=simple,proto3" json:"simple,omitempty"`
-}
-
-func (x *StartBackupRequest) Reset() {
-	*x = StartBackupRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *StartBackupRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*StartBackupRequest) ProtoMessage() {}
-
-func (x *StartBackupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use StartBackupRequest.ProtoReflect.Descriptor instead.
-func (*StartBackupRequest) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *StartBackupRequest) GetProviderId() string {
-	if x != nil {
-		return x.ProviderId
-	}
-	return ""
-}
-
-func (x *StartBackupRequest) GetTimeoutInSec() int32 {
-	if x != nil {
-		return x.TimeoutInSec
-	}
-	return 0
-}
-
-func (x *StartBackupRequest) GetSimple() bool {
-	if x != nil {
-		return x.Simple
-	}
-	return false
-}
-
-type StartBackupReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Types that are assignable to MessageOrResult:
-	//
-	//	*StartBackupReply_Message
-	//	*StartBackupReply_Result
-	MessageOrResult isStartBackupReply_MessageOrResult `protobuf_oneof:"MessageOrResult"`
-}
-
-func (x *StartBackupReply) Reset() {
-	*x = StartBackupReply{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *StartBackupReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*StartBackupReply) ProtoMessage() {}
-
-func (x *StartBackupReply) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use StartBackupReply.ProtoReflect.Descriptor instead.
-func (*StartBackupReply) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{15}
-}
-
-func (m *StartBackupReply) GetMessageOrResult() isStartBackupReply_MessageOrResult {
-	if m != nil {
-		return m.MessageOrResult
-	}
-	return nil
-}
-
-func (x *StartBackupReply) GetMessage() *OutputMessage {
-	if x, ok := x.GetMessageOrResult().(*StartBackupReply_Message); ok {
-		return x.Message
-	}
-	return nil
-}
-
-func (x *StartBackupReply) GetResult() *StartBackupResult {
-	if x, ok := x.GetMessageOrResult().(*StartBackupReply_Result); ok {
-		return x.Result
-	}
-	return nil
-}
-
-type isStartBackupReply_MessageOrResult interface {
-	isStartBackupReply_MessageOrResult()
-}
-
-type StartBackupReply_Message struct {
-	Message *OutputMessage `protobuf:"bytes,1,opt,name=message,proto3,oneof"`
-}
-
-type StartBackupReply_Result struct {
-	Result *StartBackupResult `protobuf:"bytes,2,opt,name=result,proto3,oneof"`
-}
-
-func (*StartBackupReply_Message) isStartBackupReply_MessageOrResult() {}
-
-func (*StartBackupReply_Result) isStartBackupReply_MessageOrResult() {}
-
-type StartBackupResult struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	BackuperId    uint32 `protobuf:"varint,1,opt,name=backuperId,proto3" json:"backuperId,omitempty"`
-	CaseSensitive bool   `protobuf:"varint,2,opt,name=caseSensitive,proto3" json:"caseSensitive,omitempty"`
-}
-
-func (x *StartBackupResult) Reset() {
-	*x = StartBackupResult{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *StartBackupResult) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*StartBackupResult) ProtoMessage() {}
-
-func (x *StartBackupResult) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use StartBackupResult.ProtoReflect.Descriptor instead.
-func (*StartBackupResult) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *StartBackupResult) GetBackuperId() uint32 {
-	if x != nil {
-		return x.BackuperId
-	}
-	return 0
-}
-
-func (x *StartBackupResult) GetCaseSensitive() bool {
-	if x != nil {
-		return x.CaseSensitive
-	}
-	return false
-}
-
-type TryToCreateTemporarySnapshotRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	BackuperId uint32 `protobuf:"varint,1,opt,name=backuperId,proto3" json:"backuperId,omitempty"`
-	Dir        string `protobuf:"bytes,2,opt,name=dir,proto3" json:"dir,omitempty"`
-}
-
-func (x *TryToCreateTemporarySnapshotRequest) Reset() {
-	*x = TryToCreateTemporarySnapshotRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *TryToCreateTemporarySnapshotRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*TryToCreateTemporarySnapshotRequest) ProtoMessage() {}
-
-func (x *TryToCreateTemporarySnapshotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use TryToCreateTemporarySnapshotRequest.ProtoReflect.Descriptor instead.
-func (*TryToCreateTemporarySnapshotRequest) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *TryToCreateTemporarySnapshotRequest) GetBackuperId() uint32 {
-	if x != nil {
-		return x.BackuperId
-	}
-	return 0
-}
-
-func (x *TryToCreateTemporarySnapshotRequest) GetDir() string {
-	if x != nil {
-		return x.Dir
-	}
-	return ""
-}
-
-type TryToCreateTemporarySnapshotReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Types that are assignable to MessageOrResult:
-	//
-	//	*TryToCreateTemporarySnapshotReply_Message
-	//	*TryToCreateTemporarySnapshotReply_Result
-	MessageOrResult isTryToCreateTemporarySnapshotReply_MessageOrResult `protobuf_oneof:"MessageOrResult"`
-}
-
-func (x *TryToCreateTemporarySnapshotReply) Reset() {
-	*x = TryToCreateTemporarySnapshotReply{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *TryToCreateTemporarySnapshotReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*TryToCreateTemporarySnapshotReply) ProtoMessage() {}
-
-func (x *TryToCreateTemporarySnapshotReply) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use TryToCreateTemporarySnapshotReply.ProtoReflect.Descriptor instead.
-func (*TryToCreateTemporarySnapshotReply) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{18}
-}
-
-func (m *TryToCreateTemporarySnapshotReply) GetMessageOrResult() isTryToCreateTemporarySnapshotReply_MessageOrResult {
-	if m != nil {
-		return m.MessageOrResult
-	}
-	return nil
-}
-
-func (x *TryToCreateTemporarySnapshotReply) GetMessage() *OutputMessage {
-	if x, ok := x.GetMessageOrResult().(*TryToCreateTemporarySnapshotReply_Message); ok {
-		return x.Message
-	}
-	return nil
-}
-
-func (x *TryToCreateTemporarySnapshotReply) GetResult() *TryToCreateTemporarySnapshotResult {
-	if x, ok := x.GetMessageOrResult().(*TryToCreateTemporarySnapshotReply_Result); ok {
-		return x.Result
-	}
-	return nil
-}
-
-type isTryToCreateTemporarySnapshotReply_MessageOrResult interface {
-	isTryToCreateTemporarySnapshotReply_MessageOrResult()
-}
-
-type TryToCreateTemporarySnapshotReply_Message struct {
-	Message *OutputMessage `protobuf:"bytes,1,opt,name=message,proto3,oneof"`
-}
-
-type TryToCreateTemporarySnapshotReply_Result struct {
-	Result *TryToCreateTemporarySnapshotResult `protobuf:"bytes,2,opt,name=result,proto3,oneof"`
-}
-
-func (*TryToCreateTemporarySnapshotReply_Message) isTryToCreateTemporarySnapshotReply_MessageOrResult() {
-}
-
-func (*TryToCreateTemporarySnapshotReply_Result) isTryToCreateTemporarySnapshotReply_MessageOrResult() {
-}
-
-type TryToCreateTemporarySnapshotResult struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	SnapshotDir string    `protobuf:"bytes,1,opt,name=snapshotDir,proto3" json:"snapshotDir,omitempty"`
-	Snapshot    *Snapshot `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
-}
-
-func (x *TryToCreateTemporarySnapshotResult) Reset() {
-	*x = TryToCreateTemporarySnapshotResult{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[19]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *TryToCreateTemporarySnapshotResult) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*TryToCreateTemporarySnapshotResult) ProtoMessage() {}
-
-func (x *TryToCreateTemporarySnapshotResult) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[19]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use TryToCreateTemporarySnapshotResult.ProtoReflect.Descriptor instead.
-func (*TryToCreateTemporarySnapshotResult) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *TryToCreateTemporarySnapshotResult) GetSnapshotDir() string {
-	if x != nil {
-		return x.SnapshotDir
-	}
-	return ""
-}
-
-func (x *TryToCreateTemporarySnapshotResult) GetSnapshot() *Snapshot {
-	if x != nil {
-		return x.Snapshot
-	}
-	return nil
-}
-
-type CloseBackupRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	BackuperId uint32 `protobuf:"varint,1,opt,name=backuperId,proto3" json:"backuperId,omitempty"`
-}
-
-func (x *CloseBackupRequest) Reset() {
-	*x = CloseBackupRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[20]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *CloseBackupRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CloseBackupRequest) ProtoMessage() {}
-
-func (x *CloseBackupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[20]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CloseBackupRequest.ProtoReflect.Descriptor instead.
-func (*CloseBackupRequest) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *CloseBackupRequest) GetBackuperId() uint32 {
-	if x != nil {
-		return x.BackuperId
-	}
-	return 0
-}
-
-type CloseBackupReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Message *OutputMessage `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-}
-
-func (x *CloseBackupReply) Reset() {
-	*x = CloseBackupReply{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *CloseBackupReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CloseBackupReply) ProtoMessage() {}
-
-func (x *CloseBackupReply) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[21]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CloseBackupReply.ProtoReflect.Descriptor instead.
-func (*CloseBackupReply) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *CloseBackupReply) GetMessage() *OutputMessage {
-	if x != nil {
-		return x.Message
-	}
-	return nil
-}
-
-type Provider struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
-	Type    string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-}
-
-func (x *Provider) Reset() {
-	*x = Provider{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[22]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Provider) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Provider) ProtoMessage() {}
-
-func (x *Provider) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[22]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Provider.ProtoReflect.Descriptor instead.
-func (*Provider) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *Provider) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Provider) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Provider) GetVersion() string {
-	if x != nil {
-		return x.Version
-	}
-	return ""
-}
-
-func (x *Provider) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-type SnapshotSet struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id                      string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreationTime            int64       `protobuf:"varint,2,opt,name=creationTime,proto3" json:"creationTime,omitempty"`
-	SnapshotCountOnCreation int32       `protobuf:"varint,3,opt,name=snapshotCountOnCreation,proto3" json:"snapshotCountOnCreation,omitempty"`
-	Snapshots               []*Snapshot `protobuf:"bytes,4,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
-}
-
-func (x *SnapshotSet) Reset() {
-	*x = SnapshotSet{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[23]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *SnapshotSet) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SnapshotSet) ProtoMessage() {}
-
-func (x *SnapshotSet) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[23]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SnapshotSet.ProtoReflect.Descriptor instead.
-func (*SnapshotSet) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *SnapshotSet) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *SnapshotSet) GetCreationTime() int64 {
-	if x != nil {
-		return x.CreationTime
-	}
-	return 0
-}
-
-func (x *SnapshotSet) GetSnapshotCountOnCreation() int32 {
-	if x != nil {
-		return x.SnapshotCountOnCreation
-	}
-	return 0
-}
-
-func (x *SnapshotSet) GetSnapshots() []*Snapshot {
-	if x != nil {
-		return x.Snapshots
-	}
-	return nil
-}
-
-type Snapshot struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id           string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	OriginalDir  string       `protobuf:"bytes,2,opt,name=originalDir,proto3" json:"originalDir,omitempty"`
-	SnapshotDir  string       `protobuf:"bytes,3,opt,name=snapshotDir,proto3" json:"snapshotDir,omitempty"`
-	CreationTime int64        `protobuf:"varint,4,opt,name=creationTime,proto3" json:"creationTime,omitempty"`
-	Set          *SnapshotSet `protobuf:"bytes,5,opt,name=set,proto3" json:"set,omitempty"`
-	Provider     *Provider    `protobuf:"bytes,6,opt,name=provider,proto3" json:"provider,omitempty"`
-	State        string       `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`
-	Attributes   string       `protobuf:"bytes,8,opt,name=attributes,proto3" json:"attributes,omitempty"`
-}
-
-func (x *Snapshot) Reset() {
-	*x = Snapshot{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[24]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Snapshot) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Snapshot) ProtoMessage() {}
-
-func (x *Snapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[24]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Snapshot.ProtoReflect.Descriptor instead.
-func (*Snapshot) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *Snapshot) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Snapshot) GetOriginalDir() string {
-	if x != nil {
-		return x.OriginalDir
-	}
-	return ""
-}
-
-func (x *Snapshot) GetSnapshotDir() string {
-	if x != nil {
-		return x.SnapshotDir
-	}
-	return ""
-}
-
-func (x *Snapshot) GetCreationTime() int64 {
-	if x != nil {
-		return x.CreationTime
-	}
-	return 0
-}
-
-func (x *Snapshot) GetSet() *SnapshotSet {
-	if x != nil {
-		return x.Set
-	}
-	return nil
-}
-
-func (x *Snapshot) GetProvider() *Provider {
-	if x != nil {
-		return x.Provider
-	}
-	return nil
-}
-
-func (x *Snapshot) GetState() string {
-	if x != nil {
-		return x.State
-	}
-	return ""
-}
-
-func (x *Snapshot) GetAttributes() string {
-	if x != nil {
-		return x.Attributes
-	}
-	return ""
-}
-
-type OutputMessage struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Level   MessageLevel `protobuf:"varint,1,opt,name=level,proto3,enum=rpc.MessageLevel" json:"level,omitempty"`
-	Message string       `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-}
-
-func (x *OutputMessage) Reset() {
-	*x = OutputMessage{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[25]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *OutputMessage) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*OutputMessage) ProtoMessage() {}
-
-func (x *OutputMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[25]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use OutputMessage.ProtoReflect.Descriptor instead.
-func (*OutputMessage) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *OutputMessage) GetLevel() MessageLevel {
-	if x != nil {
-		return x.Level
-	}
-	return MessageLevel_OutputLevel
-}
-
-func (x *OutputMessage) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-var File_server_proto protoreflect.FileDescriptor
-
-var file_server_proto_rawDesc = []byte{
-	0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03,
-	0x72, 0x70, 0x63, 0x22, 0x1b, 0x0a, 0x19, 0x43, 0x61, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x2b, 0x0a, 0x17, 0x43, 0x61, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61,
-	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x63,
-	0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x63, 0x61, 0x6e, 0x22, 0x32, 0x0a,
-	0x14, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x49,
-	0x64, 0x22, 0x41, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2b, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x70, 0x63,
-	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x73, 0x22, 0x2d, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x74, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x49, 0x64, 0x22, 0x35, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x74, 0x73, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x24, 0x0a, 0x04, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x53, 0x65, 0x74, 0x52, 0x04, 0x73, 0x65, 0x74, 0x73, 0x22, 0x32, 0x0a, 0x14, 0x4c, 0x69,
-	0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0x41,
-	0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x2b, 0x0a, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x73, 0x22, 0x23, 0x0a, 0x11, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x66, 0x79, 0x49, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2d, 0x0a, 0x0f, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x69,
-	0x66, 0x79, 0x49, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x69, 0x6d,
-	0x70, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x6d,
-	0x70, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x35, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x27, 0x0a, 0x0b,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x64,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x30, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x75,
-	0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22, 0x38, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x4d,
-	0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
-	0x20, 0x0a, 0x0b, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74,
-	0x73, 0x22, 0x70, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x49, 0x6e, 0x53, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74,
-	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x49, 0x6e, 0x53, 0x65, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x69, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x69, 0x6d,
-	0x70, 0x6c, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x61, 0x63,
-	0x6b, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x70, 0x63, 0x2e,
-	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x11, 0x0a, 0x0f, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x59, 0x0a,
-	0x11, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x65, 0x72, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x61, 0x73, 0x65, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74,
-	0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x61, 0x73, 0x65, 0x53,
-	0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x22, 0x57, 0x0a, 0x23, 0x54, 0x72, 0x79, 0x54,
-	0x6f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79,
-	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x10, 0x0a, 0x03, 0x64, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69,
-	0x72, 0x22, 0xa9, 0x01, 0x0a, 0x21, 0x54, 0x72, 0x79, 0x54, 0x6f, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x54, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
-	0x6f, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x72,
-	0x79, 0x54, 0x6f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
-	0x72, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x11, 0x0a, 0x0f, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x71, 0x0a,
-	0x22, 0x54, 0x72, 0x79, 0x54, 0x6f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70,
-	0x6f, 0x72, 0x61, 0x72, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x44,
-	0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
-	0x6f, 0x74, 0x44, 0x69, 0x72, 0x12, 0x29, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x22, 0x34, 0x0a, 0x12, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70,
-	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x62, 0x61, 0x63, 0x6b,
-	0x75, 0x70, 0x65, 0x72, 0x49, 0x64, 0x22, 0x40, 0x0a, 0x10, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x42,
-	0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2c, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x70,
-	0x63, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5c, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xa8, 0x01, 0x0a, 0x0b, 0x53, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x53, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x17, 0x73, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x6e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x17, 0x73, 0x6e, 0x61,
-	0x70, 0x73, 0x68, 0x6f, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x6e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x73, 0x22, 0x87, 0x02, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20,
-	0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x44, 0x69, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x44, 0x69, 0x72,
-	0x12, 0x20, 0x0a, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x44, 0x69, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x44,
-	0x69, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
-	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x03, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
-	0x6f, 0x74, 0x53, 0x65, 0x74, 0x52, 0x03, 0x73, 0x65, 0x74, 0x12, 0x29, 0x0a, 0x08, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72,
-	0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x08, 0x70, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61,
-	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x52, 0x0a, 0x0d, 0x4f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x05,
-	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x72, 0x70,
-	0x63, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05,
-	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a,
-	0x50, 0x0a, 0x0c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
-	0x0f, 0x0a, 0x0b, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x10, 0x00,
-	0x12, 0x0d, 0x0a, 0x09, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x10, 0x01, 0x12,
-	0x10, 0x0a, 0x0c, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x10,
-	0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x10,
-	0x03, 0x32, 0x9e, 0x06, 0x0a, 0x0a, 0x46, 0x73, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x12, 0x54, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61,
-	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x36, 0x0a,
-	0x08, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x74, 0x73, 0x12, 0x14, 0x2e, 0x72, 0x70, 0x63, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x12, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x74, 0x73, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6e, 0x61,
-	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70,
-	0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0a,
-	0x53, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x66, 0x79, 0x49, 0x64, 0x12, 0x16, 0x2e, 0x72, 0x70, 0x63,
-	0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x66, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x66,
-	0x79, 0x49, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x09, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x74, 0x12, 0x12, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x72, 0x70,
-	0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
-	0x38, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x12, 0x12, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0f, 0x4c, 0x69, 0x73,
-	0x74, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x72,
-	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42,
-	0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x72,
-	0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
-	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x30, 0x01, 0x12, 0x74, 0x0a, 0x1c, 0x54, 0x72, 0x79,
-	0x54, 0x6f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72,
-	0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x28, 0x2e, 0x72, 0x70, 0x63, 0x2e,
-	0x54, 0x72, 0x79, 0x54, 0x6f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6f,
-	0x72, 0x61, 0x72, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x72, 0x79, 0x54, 0x6f, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x53, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x30, 0x01, 0x12,
-	0x41, 0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x17,
-	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c,
-	0x6f, 0x73, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
-	0x30, 0x01, 0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x70, 0x65, 0x73, 0x63, 0x75, 0x6d, 0x61, 0x2f, 0x67, 0x6f, 0x2d, 0x66, 0x73, 0x2d, 0x73,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2f, 0x6c, 0x69, 0x62, 0x2f, 0x66, 0x73, 0x5f, 0x73,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x2f, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_server_proto_rawDescOnce sync.Once
-	file_server_proto_rawDescData = file_server_proto_rawDesc
-)
-
-func file_server_proto_rawDescGZIP() []byte {
-	file_server_proto_rawDescOnce.Do(func() {
-		file_server_proto_rawDescData = protoimpl.X.CompressGZIP(file_server_proto_rawDescData)
-	})
-	return file_server_proto_rawDescData
-}
-
-var file_server_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_server_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
-var file_server_proto_goTypes = []interface{}{
-	(MessageLevel)(0),                           // 0: rpc.MessageLevel
-	(*CanCreateSnapshotsRequest)(nil),           // 1: rpc.CanCreateSnapshotsRequest
-	(*CanCreateSnapshotsReply)(nil),             // 2: rpc.CanCreateSnapshotsReply
-	(*ListProvidersRequest)(nil),                // 3: rpc.ListProvidersRequest
-	(*ListProvidersReply)(nil),                  // 4: rpc.ListProvidersReply
-	(*ListSetsRequest)(nil),                     // 5: rpc.ListSetsRequest
-	(*ListSetsReply)(nil),                       // 6: rpc.ListSetsReply
-	(*ListSnapshotsRequest)(nil),                // 7: rpc.ListSnapshotsRequest
-	(*ListSnapshotsReply)(nil),                  // 8: rpc.ListSnapshotsReply
-	(*SimplifyIdRequest)(nil),                   // 9: rpc.SimplifyIdRequest
-	(*SimplifyIdReply)(nil),                     // 10: rpc.SimplifyIdReply
-	(*DeleteRequest)(nil),                       // 11: rpc.DeleteRequest
-	(*DeleteReply)(nil),                         // 12: rpc.DeleteReply
-	(*ListMountPointsRequest)(nil),              // 13: rpc.ListMountPointsRequest
-	(*ListMountPointsReply)(nil),                // 14: rpc.ListMountPointsReply
-	(*StartBackupRequest)(nil),                  // 15: rpc.StartBackupRequest
-	(*StartBackupReply)(nil),                    // 16: rpc.StartBackupReply
-	(*StartBackupResult)(nil),                   // 17: rpc.StartBackupResult
-	(*TryToCreateTemporarySnapshotRequest)(nil), // 18: rpc.TryToCreateTemporarySnapshotRequest
-	(*TryToCreateTemporarySnapshotReply)(nil),   // 19: rpc.TryToCreateTemporarySnapshotReply
-	(*TryToCreateTemporarySnapshotResult)(nil),  // 20: rpc.TryToCreateTemporarySnapshotResult
-	(*CloseBackupRequest)(nil),                  // 21: rpc.CloseBackupRequest
-	(*CloseBackupReply)(nil),                    // 22: rpc.CloseBackupReply
-	(*Provider)(nil),                            // 23: rpc.Provider
-	(*SnapshotSet)(nil),                         // 24: rpc.SnapshotSet
-	(*Snapshot)(nil),                            // 25: rpc.Snapshot
-	(*OutputMessage)(nil),                       // 26: rpc.OutputMessage
-}
-var file_server_proto_depIdxs = []int32{
-	23, // 0: rpc.ListProvidersReply.providers:type_name -> rpc.Provider
-	24, // 1: rpc.ListSetsReply.sets:type_name -> rpc.SnapshotSet
-	25, // 2: rpc.ListSnapshotsReply.snapshots:type_name -> rpc.Snapshot
-	26, // 3: rpc.StartBackupReply.message:type_name -> rpc.OutputMessage
-	17, // 4: rpc.StartBackupReply.result:type_name -> rpc.StartBackupResult
-	26, // 5: rpc.TryToCreateTemporarySnapshotReply.message:type_name -> rpc.OutputMessage
-	20, // 6: rpc.TryToCreateTemporarySnapshotReply.result:type_name -> rpc.TryToCreateTemporarySnapshotResult
-	25, // 7: rpc.TryToCreateTemporarySnapshotResult.snapshot:type_name -> rpc.Snapshot
-	26, // 8: rpc.CloseBackupReply.message:type_name -> rpc.OutputMessage
-	25, // 9: rpc.SnapshotSet.snapshots:type_name -> rpc.Snapshot
-	24, // 10: rpc.Snapshot.set:type_name -> rpc.SnapshotSet
-	23, // 11: rpc.Snapshot.provider:type_name -> rpc.Provider
-	0,  // 12: rpc.OutputMessage.level:type_name -> rpc.MessageLevel
-	1,  // 13: rpc.FsSnapshot.CanCreateSnapshots:input_type -> rpc.CanCreateSnapshotsRequest
-	3,  // 14: rpc.FsSnapshot.ListProviders:input_type -> rpc.ListProvidersRequest
-	5,  // 15: rpc.FsSnapshot.ListSets:input_type -> rpc.ListSetsRequest
-	7,  // 16: rpc.FsSnapshot.ListSnapshots:input_type -> rpc.ListSnapshotsRequest
-	9,  // 17: rpc.FsSnapshot.SimplifyId:input_type -> rpc.SimplifyIdRequest
-	11, // 18: rpc.FsSnapshot.DeleteSet:input_type -> rpc.DeleteRequest
-	11, // 19: rpc.FsSnapshot.DeleteSnapshot:input_type -> rpc.DeleteRequest
-	13, // 20: rpc.FsSnapshot.ListMountPoints:input_type -> rpc.ListMountPointsRequest
-	15, // 21: rpc.FsSnapshot.StartBackup:input_type -> rpc.StartBackupRequest
-	18, // 22: rpc.FsSnapshot.TryToCreateTemporarySnapshot:input_type -> rpc.TryToCreateTemporarySnapshotRequest
-	21, // 23: rpc.FsSnapshot.CloseBackup:input_type -> rpc.CloseBackupRequest
-	2,  // 24: rpc.FsSnapshot.CanCreateSnapshots:output_type -> rpc.CanCreateSnapshotsReply
-	4,  // 25: rpc.FsSnapshot.ListProviders:output_type -> rpc.ListProvidersReply
-	6,  // 26: rpc.FsSnapshot.ListSets:output_type -> rpc.ListSetsReply
-	8,  // 27: rpc.FsSnapshot.ListSnapshots:output_type -> rpc.ListSnapshotsReply
-	10, // 28: rpc.FsSnapshot.SimplifyId:output_type -> rpc.SimplifyIdReply
-	12, // 29: rpc.FsSnapshot.DeleteSet:output_type -> rpc.DeleteReply
-	12, // 30: rpc.FsSnapshot.DeleteSnapshot:output_type -> rpc.DeleteReply
-	14, // 31: rpc.FsSnapshot.ListMountPoints:output_type -> rpc.ListMountPointsReply
-	16, // 32: rpc.FsSnapshot.StartBackup:output_type -> rpc.StartBackupReply
-	19, // 33: rpc.FsSnapshot.TryToCreateTemporarySnapshot:output_type -> rpc.TryToCreateTemporarySnapshotReply
-	22, // 34: rpc.FsSnapshot.CloseBackup:output_type -> rpc.CloseBackupReply
-	24, // [24:35] is the sub-list for method output_type
-	13, // [13:24] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
-}
-
-func init() { file_server_proto_init() }
-func file_server_proto_init() {
-	if File_server_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_server_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CanCreateSnapshotsRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_server_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CanCreateSnapshotsReply); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_server_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListProvidersRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_server_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListProvidersReply); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_server_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSetsRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_server_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSetsReply); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_server_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSnapshotsRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_server_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSnapshotsReply); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_server_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SimplifyIdRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_server_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SimplifyIdReply); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_server_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_server_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteReply); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_server_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListMountPointsRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_server_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListMountPointsReply); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_server_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartBackupRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_server_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartBackupReply); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_server_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartBackupResult); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_server_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TryToCreateTemporarySnapshotRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_server_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TryToCreateTemporarySnapshotReply); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_server_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TryToCreateTemporarySnapshotResult); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_server_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloseBackupRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_server_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloseBackupReply); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_server_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Provider); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_server_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SnapshotSet); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_server_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Snapshot); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_server_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OutputMessage); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-	}
-	file_server_proto_msgTypes[15].OneofWrappers = []interface{}{
-		(*StartBackupReply_Message)(nil),
-		(*StartBackupReply_Result)(nil),
-	}
-	file_server_proto_msgTypes[18].OneofWrappers = []interface{}{
-		(*TryToCreateTemporarySnapshotReply_Message)(nil),
-		(*TryToCreateTemporarySnapshotReply_Result)(nil),
-	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_server_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   26,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_server_proto_goTypes,
-		DependencyIndexes: file_server_proto_depIdxs,
-		EnumInfos:         file_server_proto_enumTypes,
-		MessageInfos:      file_server_proto_msgTypes,
-	}.Build()
-	File_server_proto = out.File
-	file_server_proto_rawDesc = nil
-	file_server_proto_goTypes = nil
-	file_server_proto_depIdxs = nil
-}
@@ -4,6 +4,8 @@ package internal_windows
 
 import (
 	"fmt"
+
+	"github.com/pkg/errors"
 )
 
 // VssError encapsulates errors returned from calling VSS api.
@@ -26,3 +28,25 @@ func newVssErrorF(hresult HRESULT, format string, a ...interface{}) *VssError {
 func (e *VssError) Error() string {
 	return fmt.Sprintf("%s (%s)", e.Text, e.HResult.Str())
 }
+
+// IsTransientError returns true for VSS errors that may not happen again if the operation is retried later,
+// like another snapshot being created at the same time or writers timing out.
+func IsTransientError(err error) bool {
+	var vssErr *VssError
+	if !errors.As(err, &vssErr) {
+		return false
+	}
+
+	switch vssErr.HResult {
+	case VSS_E_SNAPSHOT_SET_IN_PROGRESS,
+		VSS_E_FLUSH_WRITES_TIMEOUT,
+		VSS_E_HOLD_WRITES_TIMEOUT,
+		VSS_E_TRANSACTION_FREEZE_TIMEOUT,
+		VSS_E_WRITERERROR_TIMEOUT,
+		VSS_E_PROVIDER_VETO,
+		VSS_E_UNEXPECTED_PROVIDER_ERROR:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,33 @@
+//go:build windows
+
+package internal_windows
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestIsTransientError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"snapshot set in progress", newVssError(VSS_E_SNAPSHOT_SET_IN_PROGRESS, "x"), true},
+		{"writer timeout", newVssError(VSS_E_WRITERERROR_TIMEOUT, "x"), true},
+		{"provider veto", newVssError(VSS_E_PROVIDER_VETO, "x"), true},
+		{"wrapped", errors.Wrap(newVssError(VSS_E_HOLD_WRITES_TIMEOUT, "x"), "error creating snapshot"), true},
+		{"bad state", newVssError(VSS_E_BAD_STATE, "x"), false},
+		{"not a VSS error", errors.New("x"), false},
+		{"nil", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsTransientError(tt.err); got != tt.want {
+				t.Errorf("IsTransientError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
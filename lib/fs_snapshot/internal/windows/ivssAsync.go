@@ -107,7 +107,7 @@ func (vssAsync *ivssAsync) WaitUntilAsyncFinished(millis uint32) error {
 	}
 
 	if state != VSS_S_ASYNC_FINISHED {
-		return errors.Errorf("async operation failed with state 0x%x", state)
+		return newVssError(HRESULT(state), "async operation failed")
 	}
 
 	return nil
@@ -0,0 +1,182 @@
+//go:build windows
+
+package internal_windows
+
+import (
+	"runtime"
+	"syscall"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/pkg/errors"
+)
+
+// clsid_vssSnapshotMgmt defines the GUID of the VssSnapshotMgmt class.
+//
+//goland:noinspection GoSnakeCaseUsage
+var clsid_vssSnapshotMgmt = ole.NewGUID("{0b5a2c52-3eb9-470a-96e2-6c6d4570e40f}")
+
+// uuid_ivssSnapshotMgmt defines the GUID of IVssSnapshotMgmt.
+//
+//goland:noinspection GoSnakeCaseUsage
+var uuid_ivssSnapshotMgmt = ole.NewGUID("{fa7df749-66e7-4986-a27f-e2f04ae53772}")
+
+// uuid_ivssDifferentialSoftwareSnapshotMgmt defines the GUID of IVssDifferentialSoftwareSnapshotMgmt.
+//
+//goland:noinspection GoSnakeCaseUsage
+var uuid_ivssDifferentialSoftwareSnapshotMgmt = ole.NewGUID("{214a0f28-b737-4026-b847-4f9e37d79529}")
+
+// SystemProviderID is the ID of the Microsoft Software Shadow Copy provider, the only one that uses diff areas.
+var SystemProviderID = ole.NewGUID("{b5946137-7b9f-4925-af80-51abd60b20d5}")
+
+func NewIVssSnapshotMgmt() (*IVssSnapshotMgmt, error) {
+	oleIUnknown, err := ole.CreateInstance(clsid_vssSnapshotMgmt, ole.IID_IUnknown)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create VSS snapshot management instance")
+	}
+
+	comInterface, err := queryInterface(oleIUnknown, uuid_ivssSnapshotMgmt)
+	if err != nil {
+		oleIUnknown.Release()
+		return nil, errors.Errorf("failed to create VSS snapshot management instance: %v", err)
+	}
+
+	sm := &IVssSnapshotMgmt{}
+	sm.iunknown = oleIUnknown
+	sm.com = (*ivssSnapshotMgmtOle)(unsafe.Pointer(comInterface))
+
+	return sm, nil
+}
+
+type IVssSnapshotMgmt struct {
+	com      *ivssSnapshotMgmtOle
+	iunknown *ole.IUnknown
+}
+
+// ivssSnapshotMgmtOle VSS api interface.
+type ivssSnapshotMgmtOle struct {
+	ole.IUnknown
+}
+
+// ivssSnapshotMgmtVTable is the vtable for ivssSnapshotMgmtOle.
+type ivssSnapshotMgmtVTable struct {
+	ole.IUnknownVtbl
+	getProviderMgmtInterface          uintptr
+	queryVolumesSupportedForSnapshots uintptr
+	querySnapshotsByVolume            uintptr
+}
+
+// getVTable returns the vtable for ivssSnapshotMgmtOle.
+func (sm *ivssSnapshotMgmtOle) getVTable() *ivssSnapshotMgmtVTable {
+	return (*ivssSnapshotMgmtVTable)(unsafe.Pointer(sm.RawVTable))
+}
+
+// GetDifferentialSoftwareSnapshotMgmt calls GetProviderMgmtInterface for the system provider.
+func (sm *IVssSnapshotMgmt) GetDifferentialSoftwareSnapshotMgmt() (*IVssDifferentialSoftwareSnapshotMgmt, error) {
+	var oleIUnknown *ole.IUnknown
+	var err error
+
+	if runtime.GOARCH == "386" {
+		id := (*[4]uintptr)(unsafe.Pointer(SystemProviderID))
+
+		err = syscallN("GetProviderMgmtInterface()", sm.com.getVTable().getProviderMgmtInterface,
+			uintptr(unsafe.Pointer(sm.com)), id[0], id[1], id[2], id[3],
+			uintptr(unsafe.Pointer(uuid_ivssDifferentialSoftwareSnapshotMgmt)),
+			uintptr(unsafe.Pointer(&oleIUnknown)))
+	} else {
+		err = syscallN("GetProviderMgmtInterface()", sm.com.getVTable().getProviderMgmtInterface,
+			uintptr(unsafe.Pointer(sm.com)), uintptr(unsafe.Pointer(SystemProviderID)),
+			uintptr(unsafe.Pointer(uuid_ivssDifferentialSoftwareSnapshotMgmt)),
+			uintptr(unsafe.Pointer(&oleIUnknown)))
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	if oleIUnknown == nil {
+		return nil, errors.New("GetProviderMgmtInterface(): received nil")
+	}
+
+	return (*IVssDifferentialSoftwareSnapshotMgmt)(unsafe.Pointer(oleIUnknown)), nil
+}
+
+func (sm *IVssSnapshotMgmt) Close() {
+	if sm == nil {
+		return
+	}
+
+	sm.com.Release()
+	sm.iunknown.Release()
+}
+
+// IVssDifferentialSoftwareSnapshotMgmt VSS api interface.
+type IVssDifferentialSoftwareSnapshotMgmt struct {
+	ole.IUnknown
+}
+
+// ivssDifferentialSoftwareSnapshotMgmtVTable is the vtable for IVssDifferentialSoftwareSnapshotMgmt.
+type ivssDifferentialSoftwareSnapshotMgmtVTable struct {
+	ole.IUnknownVtbl
+	addDiffArea                       uintptr
+	changeDiffAreaMaximumSize         uintptr
+	queryVolumesSupportedForDiffAreas uintptr
+	queryDiffAreasForVolume           uintptr
+	queryDiffAreasOnVolume            uintptr
+	queryDiffAreasForSnapshot         uintptr
+}
+
+// getVTable returns the vtable for IVssDifferentialSoftwareSnapshotMgmt.
+func (dm *IVssDifferentialSoftwareSnapshotMgmt) getVTable() *ivssDifferentialSoftwareSnapshotMgmtVTable {
+	return (*ivssDifferentialSoftwareSnapshotMgmtVTable)(unsafe.Pointer(dm.RawVTable))
+}
+
+// QueryDiffAreasForVolume calls the equivalent VSS api. The returned enum has VssMgmtObjectProperties.
+func (dm *IVssDifferentialSoftwareSnapshotMgmt) QueryDiffAreasForVolume(volumeName string) (*IVssEnumObject, error) {
+	volumeNamePointer, err := syscall.UTF16PtrFromString(volumeName)
+	if err != nil {
+		return nil, err
+	}
+
+	// IVssEnumMgmtObject has the same vtable as IVssEnumObject
+	var enum *IVssEnumObject
+
+	err = syscallN("QueryDiffAreasForVolume()", dm.getVTable().queryDiffAreasForVolume,
+		uintptr(unsafe.Pointer(dm)), uintptr(unsafe.Pointer(volumeNamePointer)),
+		uintptr(unsafe.Pointer(&enum)))
+
+	return enum, err
+}
+
+// ChangeDiffAreaMaximumSize calls the equivalent VSS api.
+func (dm *IVssDifferentialSoftwareSnapshotMgmt) ChangeDiffAreaMaximumSize(volumeName string, diffAreaVolumeName string,
+	maximumDiffSpace int64) error {
+
+	volumeNamePointer, err := syscall.UTF16PtrFromString(volumeName)
+	if err != nil {
+		return err
+	}
+
+	diffAreaVolumeNamePointer, err := syscall.UTF16PtrFromString(diffAreaVolumeName)
+	if err != nil {
+		return err
+	}
+
+	if runtime.GOARCH == "386" {
+		return syscallN("ChangeDiffAreaMaximumSize()", dm.getVTable().changeDiffAreaMaximumSize,
+			uintptr(unsafe.Pointer(dm)), uintptr(unsafe.Pointer(volumeNamePointer)),
+			uintptr(unsafe.Pointer(diffAreaVolumeNamePointer)),
+			uintptr(uint32(maximumDiffSpace)), uintptr(uint32(maximumDiffSpace>>32)))
+	} else {
+		return syscallN("ChangeDiffAreaMaximumSize()", dm.getVTable().changeDiffAreaMaximumSize,
+			uintptr(unsafe.Pointer(dm)), uintptr(unsafe.Pointer(volumeNamePointer)),
+			uintptr(unsafe.Pointer(diffAreaVolumeNamePointer)), uintptr(maximumDiffSpace))
+	}
+}
+
+func (dm *IVssDifferentialSoftwareSnapshotMgmt) Close() {
+	if dm == nil {
+		return
+	}
+
+	dm.Release()
+}
@@ -66,6 +66,10 @@ type SnapshotOptions struct {
 	Timeout      time.Duration
 	Writters     bool
 	InfoCallback InfoMessageCallback
+
+	// SnapshotSetCallback, if set, is called with the snapshot set ID before any snapshot is created.
+	// If it returns an error the snapshot creation is aborted.
+	SnapshotSetCallback func(setID *ole.GUID) error
 }
 
 type InfoMessageCallback func(level MessageLevel, format string, a ...interface{})
@@ -171,6 +175,13 @@ func CreateSnapshots(volumes []string, opts *SnapshotOptions) (*SnapshotsResult,
 
 	opts.InfoCallback(TraceLevel, "Set ID: %v", r.setID)
 
+	if opts.SnapshotSetCallback != nil {
+		err = opts.SnapshotSetCallback(r.setID)
+		if err != nil {
+			return &r, err
+		}
+	}
+
 	for _, volume := range volumes {
 		info := &volumeSnapshotInfo{}
 		r.volumes[volume] = info
@@ -0,0 +1,118 @@
+//go:build windows
+
+package internal_windows
+
+import (
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+)
+
+// VssMgmtObjectProperties is a VSS_MGMT_OBJECT_PROP with a VSS_DIFF_AREA_PROP, that is the biggest type in the
+// union.
+type VssMgmtObjectProperties struct {
+	ObjectType uint32
+	padding    [4]byte // The union is 8 byte aligned, also in 32 bits
+	DiffArea   VssDiffAreaProperties
+}
+
+//goland:noinspection GoSnakeCaseUsage
+const (
+	VSS_MGMT_OBJECT_UNKNOWN     uint32 = 0
+	VSS_MGMT_OBJECT_VOLUME      uint32 = 1
+	VSS_MGMT_OBJECT_DIFF_VOLUME uint32 = 2
+	VSS_MGMT_OBJECT_DIFF_AREA   uint32 = 3
+)
+
+// VssDiffAreaProperties defines the properties of a diff area as part of the VSS api.
+type VssDiffAreaProperties struct {
+	VolumeName         *uint16
+	DiffAreaVolumeName *uint16
+	MaximumDiffSpace   int64
+	AllocatedDiffSpace int64
+	UsedDiffSpace      int64
+}
+
+func (p *VssDiffAreaProperties) Close() {
+	ole.CoTaskMemFree(uintptr(unsafe.Pointer(p.VolumeName)))
+	p.VolumeName = nil
+	ole.CoTaskMemFree(uintptr(unsafe.Pointer(p.DiffAreaVolumeName)))
+	p.DiffAreaVolumeName = nil
+}
+
+// DiffArea is where the system provider stores the copy-on-write data of the snapshots of a volume.
+type DiffArea struct {
+	Volume         string
+	DiffAreaVolume string
+	// MaximumSpace is -1 if there is no limit
+	MaximumSpace   int64
+	AllocatedSpace int64
+	UsedSpace      int64
+}
+
+// QueryDiffAreasForVolume returns the diff areas used by the snapshots of a volume.
+func QueryDiffAreasForVolume(volume string) ([]*DiffArea, error) {
+	sm, err := NewIVssSnapshotMgmt()
+	if err != nil {
+		return nil, err
+	}
+	defer sm.Close()
+
+	dm, err := sm.GetDifferentialSoftwareSnapshotMgmt()
+	if err != nil {
+		return nil, err
+	}
+	defer dm.Close()
+
+	enum, err := dm.QueryDiffAreasForVolume(volume)
+	defer enum.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*DiffArea
+
+	for {
+		var props VssMgmtObjectProperties
+
+		count, err := enum.Next(1, unsafe.Pointer(&props))
+		if err != nil {
+			return nil, err
+		}
+
+		if count < 1 {
+			break
+		}
+
+		if props.ObjectType == VSS_MGMT_OBJECT_DIFF_AREA {
+			result = append(result, &DiffArea{
+				Volume:         ole.UTF16PtrToString(props.DiffArea.VolumeName),
+				DiffAreaVolume: ole.UTF16PtrToString(props.DiffArea.DiffAreaVolumeName),
+				MaximumSpace:   props.DiffArea.MaximumDiffSpace,
+				AllocatedSpace: props.DiffArea.AllocatedDiffSpace,
+				UsedSpace:      props.DiffArea.UsedDiffSpace,
+			})
+
+			props.DiffArea.Close()
+		}
+	}
+
+	return result, nil
+}
+
+// ChangeDiffAreaMaximumSize changes the maximum space the snapshots of a volume can use in a diff area.
+func ChangeDiffAreaMaximumSize(volume string, diffAreaVolume string, maximumSpace int64) error {
+	sm, err := NewIVssSnapshotMgmt()
+	if err != nil {
+		return err
+	}
+	defer sm.Close()
+
+	dm, err := sm.GetDifferentialSoftwareSnapshotMgmt()
+	if err != nil {
+		return err
+	}
+	defer dm.Close()
+
+	return dm.ChangeDiffAreaMaximumSize(volume, diffAreaVolume, maximumSpace)
+}
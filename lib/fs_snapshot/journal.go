@@ -0,0 +1,329 @@
+package fs_snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// journal keeps an on-disk record of the temporary snapshots and mounts created by a backuper.
+// Entries are written before the resource is created and removed after it is deleted, so if
+// the process dies in the middle, the next cleanup can find and delete what was left behind.
+type journal struct {
+	path string
+
+	mutex  sync.Mutex
+	data   journalData
+	nextID int
+}
+
+type journalData struct {
+	PID          int             `json:"pid"`
+	CreationTime time.Time       `json:"creationTime"`
+	Entries      []*journalEntry `json:"entries"`
+}
+
+type journalEntry struct {
+	ID           int              `json:"id"`
+	Type         journalEntryType `json:"type"`
+	Data         string           `json:"data"`
+	CreationTime time.Time        `json:"creationTime"`
+}
+
+type journalEntryType string
+
+const (
+	journalTmutilSnapshot journalEntryType = "tmutil-snapshot"
+	journalMount          journalEntryType = "mount"
+	journalDir            journalEntryType = "dir"
+	journalVssSet         journalEntryType = "vss-set"
+	journalFile           journalEntryType = "file"
+	journalLvmSnapshot    journalEntryType = "lvm-snapshot"
+)
+
+const journalExtension = ".json"
+
+// temporaryPrefix is the prefix of the names of the dirs and files created by the backupers.
+const temporaryPrefix = "fs_snapshot_"
+
+// getJournalDir is private to the user running the process, because the journals of dead processes are undone
+// by the next cleanup. It is a var so tests can change it.
+var getJournalDir = func() string {
+	return filepath.Join(getStateDir(), "journal")
+}
+
+// getMountsDir returns where the snapshots are mounted, in dirs with temporaryPrefix.
+func getMountsDir() string {
+	return filepath.Join(getStateDir(), "mounts")
+}
+
+// isTemporaryPath returns true if the path was created by a backuper inside dir. Used to make sure the cleanup
+// only deletes what it created.
+func isTemporaryPath(path string, dir string) bool {
+	path = filepath.Clean(path)
+
+	return filepath.Dir(path) == filepath.Clean(dir) && strings.HasPrefix(filepath.Base(path), temporaryPrefix)
+}
+
+func newJournal() *journal {
+	now := time.Now()
+
+	return &journal{
+		path: filepath.Join(getJournalDir(), fmt.Sprintf("%v-%v%v", os.Getpid(), now.UnixNano(), journalExtension)),
+		data: journalData{
+			PID:          os.Getpid(),
+			CreationTime: now,
+		},
+	}
+}
+
+// Add records a new entry and writes it to disk.
+// Data can be empty if it is only known after creating the resource, and filled later with Update.
+func (j *journal) Add(t journalEntryType, data string) (*journalEntry, error) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	j.nextID++
+
+	e := &journalEntry{
+		ID:           j.nextID,
+		Type:         t,
+		Data:         data,
+		CreationTime: time.Now(),
+	}
+
+	j.data.Entries = append(j.data.Entries, e)
+
+	err := j.write()
+	if err != nil {
+		j.data.Entries = j.data.Entries[:len(j.data.Entries)-1]
+		return nil, errors.Wrapf(err, "error writing journal %v", j.path)
+	}
+
+	return e, nil
+}
+
+func (j *journal) Update(e *journalEntry, data string) error {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	e.Data = data
+
+	err := j.write()
+	if err != nil {
+		return errors.Wrapf(err, "error writing journal %v", j.path)
+	}
+
+	return nil
+}
+
+// Remove removes an entry after the resource has been deleted.
+// When there are no more entries the journal file is deleted.
+func (j *journal) Remove(e *journalEntry) error {
+	if e == nil {
+		return nil
+	}
+
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	for i, o := range j.data.Entries {
+		if o == e {
+			j.data.Entries = append(j.data.Entries[:i], j.data.Entries[i+1:]...)
+			break
+		}
+	}
+
+	if len(j.data.Entries) == 0 {
+		err := os.Remove(j.path)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+
+		return nil
+	}
+
+	return j.write()
+}
+
+func (j *journal) write() error {
+	err := createPrivateDir(filepath.Dir(j.path))
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(&j.data, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp := j.path + ".tmp"
+
+	err = os.WriteFile(tmp, data, 0o600)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, j.path)
+}
+
+func readJournal(path string) (*journal, error) {
+	// Only the journals written by this user can be trusted
+	err := checkPrivatePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &journal{
+		path: path,
+	}
+
+	err = json.Unmarshal(data, &result.data)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range result.data.Entries {
+		if e.ID > result.nextID {
+			result.nextID = e.ID
+		}
+	}
+
+	return result, nil
+}
+
+// listJournalFiles returns the paths of the journals. Returns an error if the dir can't be trusted.
+func listJournalFiles() ([]string, error) {
+	dir := getJournalDir()
+
+	err := checkPrivatePath(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "refusing to use the journals")
+	}
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing journals in %v", dir)
+	}
+
+	var result []string
+
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), journalExtension) {
+			continue
+		}
+
+		result = append(result, filepath.Join(dir, f.Name()))
+	}
+
+	return result, nil
+}
+
+// cleanupOrphanedJournals deletes the resources recorded in journals whose owning process is not running anymore.
+// Entries are undone in the reverse order they were created. Entries that fail are kept, so they are retried
+// in the next cleanup.
+// Returns the number of entries cleaned.
+func cleanupOrphanedJournals(undo func(e *journalEntry) error, infoCb InfoMessageCallback) (int, error) {
+	paths, err := listJournalFiles()
+	if err != nil {
+		return 0, err
+	}
+
+	cleaned := 0
+
+	for _, path := range paths {
+		j, err := readJournal(path)
+		if err != nil {
+			infoCb(InfoLevel, "Ignoring journal %v: %v", path, err)
+			continue
+		}
+
+		if j.data.PID == os.Getpid() || processExists(j.data.PID) {
+			continue
+		}
+
+		infoCb(DetailsLevel, "Cleaning up temporary snapshots left by process %v (started at %v)",
+			j.data.PID, j.data.CreationTime.Local().Format("2006-01-02 15:04:05"))
+
+		entries := append([]*journalEntry{}, j.data.Entries...)
+
+		for i := len(entries) - 1; i >= 0; i-- {
+			e := entries[i]
+
+			err = undo(e)
+			if err != nil {
+				infoCb(InfoLevel, "Error cleaning up %v %v: %v", e.Type, e.Data, err)
+				continue
+			}
+
+			err = j.Remove(e)
+			if err != nil {
+				infoCb(InfoLevel, "Error writing journal %v: %v", path, err)
+			}
+
+			cleaned++
+		}
+
+		if len(j.data.Entries) == 0 {
+			// Could happen if the process died while writing it
+			_ = os.Remove(path)
+		}
+	}
+
+	return cleaned, nil
+}
+
+// listActiveJournalEntries returns the finished entries of the journals of running processes (including this one),
+// that are the temporary snapshots and mounts in use.
+func listActiveJournalEntries() ([]*journalEntry, error) {
+	paths, err := listJournalFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*journalEntry
+
+	for _, path := range paths {
+		j, err := readJournal(path)
+		if err != nil {
+			// Its process may have just deleted it
+			continue
+		}
+
+		if j.data.PID != os.Getpid() && !processExists(j.data.PID) {
+			continue
+		}
+
+		for _, e := range j.data.Entries {
+			if e.Data != "" {
+				result = append(result, e)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// cleanupTemporarySnapshots is used before starting backups and servers, so it only logs errors.
+func cleanupTemporarySnapshots(s Snapshoter, infoCb InfoMessageCallback) {
+	cleaned, err := s.CleanupTemporarySnapshots()
+	if err != nil {
+		infoCb(InfoLevel, "Error cleaning up temporary snapshots: %v", err)
+	} else if cleaned > 0 {
+		infoCb(InfoLevel, "Cleaned up %v temporary snapshots and mounts left by previous executions", cleaned)
+	}
+}
@@ -0,0 +1,248 @@
+package fs_snapshot
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func useTempJournalDir(t *testing.T) string {
+	dir := filepath.Join(t.TempDir(), "journal")
+
+	old := getJournalDir
+	getJournalDir = func() string { return dir }
+	t.Cleanup(func() { getJournalDir = old })
+
+	return dir
+}
+
+// deadPID returns the PID of a process that already finished.
+func deadPID(t *testing.T) int {
+	cmd := exec.Command("go", "version")
+	err := cmd.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return cmd.Process.Pid
+}
+
+func writeOrphanedJournal(t *testing.T, entries ...*journalEntry) *journal {
+	j := newJournal()
+	j.data.PID = deadPID(t)
+
+	for _, e := range entries {
+		_, err := j.Add(e.Type, e.Data)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return j
+}
+
+func noInfo(level MessageLevel, format string, a ...interface{}) {
+}
+
+func TestJournalAddUpdateRemove(t *testing.T) {
+	useTempJournalDir(t)
+
+	j := newJournal()
+
+	e1, err := j.Add(journalDir, "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e2, err := j.Add(journalMount, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = j.Update(e2, "b")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	read, err := readJournal(j.path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if read.data.PID != os.Getpid() || len(read.data.Entries) != 2 || read.nextID != 2 {
+		t.Fatalf("unexpected journal: %+v", read.data)
+	}
+	if read.data.Entries[1].Type != journalMount || read.data.Entries[1].Data != "b" {
+		t.Errorf("unexpected entry: %+v", read.data.Entries[1])
+	}
+
+	info, err := os.Stat(filepath.Dir(j.path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if runtime.GOOS != "windows" && info.Mode().Perm()&0o077 != 0 {
+		t.Errorf("journal dir is accessible by others: %v", info.Mode())
+	}
+
+	for _, e := range []*journalEntry{e1, e2} {
+		err = j.Remove(e)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, err = os.Stat(j.path)
+	if !os.IsNotExist(err) {
+		t.Errorf("journal not deleted after removing all entries: %v", err)
+	}
+}
+
+func TestCleanupOrphanedJournals(t *testing.T) {
+	useTempJournalDir(t)
+
+	orphan := writeOrphanedJournal(t,
+		&journalEntry{Type: journalDir, Data: "first"},
+		&journalEntry{Type: journalMount, Data: "fails"},
+		&journalEntry{Type: journalMount, Data: "last"},
+	)
+
+	active := newJournal()
+	_, err := active.Add(journalDir, "active")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var undone []string
+	cleaned, err := cleanupOrphanedJournals(func(e *journalEntry) error {
+		undone = append(undone, e.Data)
+		if e.Data == "fails" {
+			return os.ErrPermission
+		}
+		return nil
+	}, noInfo)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cleaned != 2 {
+		t.Errorf("cleaned = %v, want 2", cleaned)
+	}
+
+	want := []string{"last", "fails", "first"}
+	if len(undone) != len(want) {
+		t.Fatalf("undone = %v, want %v", undone, want)
+	}
+	for i := range want {
+		if undone[i] != want[i] {
+			t.Fatalf("undone = %v, want %v", undone, want)
+		}
+	}
+
+	// The failed entry is kept to be retried
+	read, err := readJournal(orphan.path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(read.data.Entries) != 1 || read.data.Entries[0].Data != "fails" {
+		t.Errorf("unexpected entries left: %+v", read.data.Entries)
+	}
+
+	entries, err := listActiveJournalEntries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Data != "active" {
+		t.Errorf("unexpected active entries: %+v", entries)
+	}
+}
+
+func TestCleanupOrphanedJournalsRefusesUntrustedFiles(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses unix permissions")
+	}
+
+	dir := useTempJournalDir(t)
+
+	orphan := writeOrphanedJournal(t, &journalEntry{Type: journalDir, Data: "planted"})
+
+	undo := func(e *journalEntry) error {
+		t.Errorf("undo called for %v", e.Data)
+		return nil
+	}
+
+	// A journal that others can write
+	err := os.Chmod(orphan.path, 0o666)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cleaned, err := cleanupOrphanedJournals(undo, noInfo)
+	if err != nil || cleaned != 0 {
+		t.Errorf("cleaned = %v, err = %v", cleaned, err)
+	}
+
+	// A journal dir that others can write
+	err = os.Chmod(orphan.path, 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.Chmod(dir, 0o777)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = cleanupOrphanedJournals(undo, noInfo)
+	if err == nil {
+		t.Error("expected error for a journal dir writable by others")
+	}
+
+	_, err = listActiveJournalEntries()
+	if err == nil {
+		t.Error("expected error for a journal dir writable by others")
+	}
+
+	// A journal owned by another user
+	if os.Geteuid() != 0 {
+		return
+	}
+
+	err = os.Chmod(dir, 0o700)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.Chown(orphan.path, 65534, 65534)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cleaned, err = cleanupOrphanedJournals(undo, noInfo)
+	if err != nil || cleaned != 0 {
+		t.Errorf("cleaned = %v, err = %v", cleaned, err)
+	}
+}
+
+func TestIsTemporaryPath(t *testing.T) {
+	dir := filepath.Join(string(os.PathSeparator)+"state", "mounts")
+
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{filepath.Join(dir, "fs_snapshot_123"), true},
+		{filepath.Join(dir, "fs_snapshot_123") + string(os.PathSeparator), true},
+		{filepath.Join(dir, "other"), false},
+		{filepath.Join(dir, "sub", "fs_snapshot_123"), false},
+		{filepath.Join(dir, "..", "fs_snapshot_123"), false},
+		{dir, false},
+		{string(os.PathSeparator) + "fs_snapshot_123", false},
+	}
+
+	for _, test := range tests {
+		got := isTemporaryPath(test.path, dir)
+		if got != test.want {
+			t.Errorf("isTemporaryPath(%q) = %v, want %v", test.path, got, test.want)
+		}
+	}
+}
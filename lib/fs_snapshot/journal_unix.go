@@ -0,0 +1,64 @@
+//go:build !windows
+
+package fs_snapshot
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+// getStateDir returns where the journals and the other private files are stored. Root uses a system dir, and
+// other users a dir in their home, so no one else can write to them.
+func getStateDir() string {
+	if os.Geteuid() == 0 {
+		return "/var/lib/fs_snapshot"
+	}
+
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return filepath.Join(os.TempDir(), fmt.Sprintf("fs_snapshot_%v", os.Geteuid()))
+	}
+
+	return filepath.Join(dir, "fs_snapshot")
+}
+
+// createPrivateDir creates a dir only writable by the current user, or checks that an existing one is.
+func createPrivateDir(dir string) error {
+	err := os.MkdirAll(dir, 0o700)
+	if err != nil {
+		return err
+	}
+
+	return checkPrivatePath(dir)
+}
+
+// checkPrivatePath returns an error if the path is not owned by the current user or if others can write to it.
+func checkPrivatePath(path string) error {
+	info, err := os.Lstat(path)
+	if err != nil {
+		return err
+	}
+
+	if info.Mode()&os.ModeSymlink != 0 {
+		return errors.Errorf("%v is a symbolic link", path)
+	}
+
+	st, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return errors.Errorf("unable to get the owner of %v", path)
+	}
+
+	if int(st.Uid) != os.Geteuid() {
+		return errors.Errorf("%v is not owned by the current user", path)
+	}
+
+	if info.Mode().Perm()&0o022 != 0 {
+		return errors.Errorf("%v can be written by other users", path)
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+//go:build windows
+
+package fs_snapshot
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/windows"
+)
+
+// getStateDir returns where the journals and the other private files are stored. Elevated processes use a dir
+// only writable by administrators, and other users a dir in their profile.
+func getStateDir() string {
+	if windows.GetCurrentProcessToken().IsElevated() {
+		return DefaultCredentialsDir()
+	}
+
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return filepath.Join(os.TempDir(), "fs_snapshot")
+	}
+
+	return filepath.Join(dir, "fs_snapshot")
+}
+
+// createPrivateDir creates a dir only writable by the current user, or checks that an existing one is.
+func createPrivateDir(dir string) error {
+	var err error
+	if windows.GetCurrentProcessToken().IsElevated() {
+		err = createSecureDir(dir, nil, true)
+	} else {
+		err = os.MkdirAll(dir, 0o700)
+	}
+	if err != nil {
+		return err
+	}
+
+	return checkPrivatePath(dir)
+}
+
+// checkPrivatePath returns an error if the path is not owned by the current user. Files created by elevated
+// processes are owned by the Administrators group.
+func checkPrivatePath(path string) error {
+	info, err := os.Lstat(path)
+	if err != nil {
+		return err
+	}
+
+	if info.Mode()&os.ModeSymlink != 0 {
+		return errors.Errorf("%v is a symbolic link", path)
+	}
+
+	sd, err := windows.GetNamedSecurityInfo(path, windows.SE_FILE_OBJECT, windows.OWNER_SECURITY_INFORMATION)
+	if err != nil {
+		return errors.Wrapf(err, "error getting the owner of %v", path)
+	}
+
+	owner, _, err := sd.Owner()
+	if err != nil {
+		return errors.Wrapf(err, "error getting the owner of %v", path)
+	}
+
+	token := windows.GetCurrentProcessToken()
+
+	user, err := token.GetTokenUser()
+	if err != nil {
+		return err
+	}
+
+	allowed := []*windows.SID{user.User.Sid}
+
+	if token.IsElevated() {
+		for _, t := range []windows.WELL_KNOWN_SID_TYPE{windows.WinBuiltinAdministratorsSid, windows.WinLocalSystemSid} {
+			sid, err := windows.CreateWellKnownSid(t)
+			if err != nil {
+				return err
+			}
+
+			allowed = append(allowed, sid)
+		}
+	}
+
+	for _, sid := range allowed {
+		if owner.Equals(sid) {
+			return nil
+		}
+	}
+
+	return errors.Errorf("%v is not owned by the current user", path)
+}
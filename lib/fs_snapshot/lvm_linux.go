@@ -0,0 +1,207 @@
+//go:build linux
+
+package fs_snapshot
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const lvmProviderID = "lvm"
+
+// lvmSnapshotPercent is the initial copy-on-write space of thick snapshots, as a percentage of the size of their
+// origin. It is limited by the free space of the volume group, and can be extended by the monitor.
+const lvmSnapshotPercent = 10
+
+// lvsFields are the fields read by listLvmVolumes, in order.
+const lvsFields = "vg_name,lv_name,lv_path,lv_attr,lv_size,origin,pool_lv,data_percent,lv_time,vg_free"
+
+// lvmVolume is a logical volume, as returned by lvs.
+type lvmVolume struct {
+	VG     string
+	Name   string
+	Path   string
+	Attr   string
+	Size   int64
+	Origin string // Only for snapshots
+	Pool   string // Only for thin volumes
+	// DataPercent is the percentage used of the copy-on-write space (for thick snapshots) or of the data (for thin
+	// pools), or -1 if unknown
+	DataPercent  float64
+	CreationTime time.Time
+	VGFree       int64
+}
+
+func (v *lvmVolume) ID() string {
+	return v.VG + "/" + v.Name
+}
+
+func (v *lvmVolume) isSnapshot() bool {
+	return v.Origin != ""
+}
+
+func (v *lvmVolume) isThin() bool {
+	return v.Pool != ""
+}
+
+// isInvalid returns true for snapshots that overflowed their copy-on-write space.
+func (v *lvmVolume) isInvalid() bool {
+	if len(v.Attr) < 5 {
+		return false
+	}
+
+	return v.Attr[0] == 'S' || v.Attr[4] == 'I' || v.Attr[4] == 'S'
+}
+
+// usedSize returns how much of the copy-on-write space of a thick snapshot is used, or -1 if unknown.
+func (v *lvmVolume) usedSize() int64 {
+	if v.isThin() || v.DataPercent < 0 {
+		return -1
+	}
+
+	return int64(float64(v.Size) * v.DataPercent / 100)
+}
+
+// lvmAvailable returns true if LVM snapshots can be created: the process must be root and have the lvm2 tools.
+func lvmAvailable() bool {
+	if os.Geteuid() != 0 {
+		return false
+	}
+
+	_, err := exec.LookPath("lvs")
+	return err == nil
+}
+
+func listLvmVolumes(infoCb InfoMessageCallback) ([]*lvmVolume, error) {
+	output, err := runAndReturnOutput(infoCb, "lvs", "--noheadings", "--nosuffix", "--units", "b",
+		"--separator", "|", "-o", lvsFields)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing LVM logical volumes: %v", output)
+	}
+
+	return parseLvs(output), nil
+}
+
+// parseLvs parses the output of lvs with lvsFields. Other lines (like warnings) are ignored.
+func parseLvs(output string) []*lvmVolume {
+	var result []*lvmVolume
+
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Split(line, "|")
+		if len(fields) != 10 {
+			continue
+		}
+
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+
+		size, err := strconv.ParseInt(fields[4], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		vgFree, err := strconv.ParseInt(fields[9], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		dataPercent := -1.0
+		if fields[7] != "" {
+			p, err := strconv.ParseFloat(fields[7], 64)
+			if err == nil {
+				dataPercent = p
+			}
+		}
+
+		creationTime, _ := time.Parse("2006-01-02 15:04:05 -0700", fields[8])
+
+		result = append(result, &lvmVolume{
+			VG:           fields[0],
+			Name:         fields[1],
+			Path:         fields[2],
+			Attr:         fields[3],
+			Size:         size,
+			Origin:       fields[5],
+			Pool:         fields[6],
+			DataPercent:  dataPercent,
+			CreationTime: creationTime,
+			VGFree:       vgFree,
+		})
+	}
+
+	return result
+}
+
+// findLvmVolume returns the logical volume with an ID, or nil if there is none.
+func findLvmVolume(volumes []*lvmVolume, vg string, name string) *lvmVolume {
+	for _, v := range volumes {
+		if v.VG == vg && v.Name == name {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// findLvmVolumeOfDevice returns the logical volume of a device (like /dev/mapper/vg-lv), or nil if it is not one.
+func findLvmVolumeOfDevice(volumes []*lvmVolume, device string) *lvmVolume {
+	dev, err := filepath.EvalSymlinks(device)
+	if err != nil {
+		return nil
+	}
+
+	for _, v := range volumes {
+		if v.Path == "" {
+			continue
+		}
+
+		p, err := filepath.EvalSymlinks(v.Path)
+		if err == nil && p == dev {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// newLvmSnapshotName returns a name with temporaryPrefix, so the cleanup knows it was created by a backuper.
+func newLvmSnapshotName() (string, error) {
+	random := make([]byte, 4)
+
+	_, err := rand.Read(random)
+	if err != nil {
+		return "", err
+	}
+
+	return temporaryPrefix + time.Now().Format("20060102_150405_") + hex.EncodeToString(random), nil
+}
+
+// parseLvmSnapshotID returns the volume group and the name of a snapshot created by a backuper.
+func parseLvmSnapshotID(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || !strings.HasPrefix(parts[1], temporaryPrefix) {
+		return "", "", errors.Errorf("invalid LVM snapshot: %v", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func removeLvmSnapshot(infoCb InfoMessageCallback, id string) error {
+	infoCb(DetailsLevel, "Deleting LVM snapshot %v", id)
+
+	output, err := runAndReturnOutput(infoCb, "lvremove", "--force", id)
+	if err != nil {
+		return errors.Wrapf(err, "error deleting LVM snapshot %v: %v", id, output)
+	}
+
+	return nil
+}
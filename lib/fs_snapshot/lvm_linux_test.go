@@ -0,0 +1,129 @@
+//go:build linux
+
+package fs_snapshot
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+const testLvsOutput = `  WARNING: Failed to connect to lvmetad. Falling back to device scanning.
+  vg0|root|/dev/vg0/root|-wi-ao----|21474836480||||2022-10-01 12:00:00 +0000|5368709120
+  vg0|fs_snapshot_20221001_120000_0a1b2c3d|/dev/vg0/fs_snapshot_20221001_120000_0a1b2c3d|swi-aos---|2147483648|root||25.00|2022-10-01 12:00:00 +0000|5368709120
+  vg0|broken|/dev/vg0/broken|swi-I-s---|1073741824|root||100.00|2022-10-01 12:00:00 +0000|5368709120
+  vg1|pool|/dev/vg1/pool|twi-aotz--|10737418240|||40.50|2022-10-01 12:00:00 +0000|0
+  vg1|data|/dev/vg1/data|Vwi-aotz--|5368709120||pool|30.00|2022-10-01 12:00:00 +0000|0
+  vg1|snap|/dev/vg1/snap|Vwi---tz-k|5368709120|data|pool|30.00|2022-10-01 12:00:00 +0000|0
+`
+
+func TestParseLvs(t *testing.T) {
+	volumes := parseLvs(testLvsOutput)
+
+	if len(volumes) != 6 {
+		t.Fatalf("got %v volumes, want 6", len(volumes))
+	}
+
+	tests := []struct {
+		id          string
+		snapshot    bool
+		thin        bool
+		invalid     bool
+		dataPercent float64
+		used        int64
+	}{
+		{"vg0/root", false, false, false, -1, -1},
+		{"vg0/fs_snapshot_20221001_120000_0a1b2c3d", true, false, false, 25, 536870912},
+		{"vg0/broken", true, false, true, 100, 1073741824},
+		{"vg1/pool", false, false, false, 40.5, 4348654387},
+		{"vg1/data", false, true, false, 30, -1},
+		{"vg1/snap", true, true, false, 30, -1},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			v := volumes[i]
+
+			if v.ID() != tt.id {
+				t.Fatalf("ID() = %v, want %v", v.ID(), tt.id)
+			}
+			if v.isSnapshot() != tt.snapshot {
+				t.Errorf("isSnapshot() = %v, want %v", v.isSnapshot(), tt.snapshot)
+			}
+			if v.isThin() != tt.thin {
+				t.Errorf("isThin() = %v, want %v", v.isThin(), tt.thin)
+			}
+			if v.isInvalid() != tt.invalid {
+				t.Errorf("isInvalid() = %v, want %v", v.isInvalid(), tt.invalid)
+			}
+			if v.DataPercent != tt.dataPercent {
+				t.Errorf("DataPercent = %v, want %v", v.DataPercent, tt.dataPercent)
+			}
+			if v.usedSize() != tt.used {
+				t.Errorf("usedSize() = %v, want %v", v.usedSize(), tt.used)
+			}
+			if !v.CreationTime.Equal(time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)) {
+				t.Errorf("CreationTime = %v", v.CreationTime)
+			}
+		})
+	}
+}
+
+func TestNewLvmSnapshotSize(t *testing.T) {
+	volumes := parseLvs(testLvsOutput)
+
+	tests := []struct {
+		id        string
+		exclusive int64
+		cowUsage  float64
+	}{
+		{"vg0/fs_snapshot_20221001_120000_0a1b2c3d", 536870912, 25},
+		{"vg1/snap", -1, 40.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			parts := strings.Split(tt.id, "/")
+			size := newLvmSnapshotSize(findLvmVolume(volumes, parts[0], parts[1]), volumes)
+
+			if size.Exclusive != tt.exclusive || size.Shared != -1 || size.CowUsage != tt.cowUsage {
+				t.Errorf("got %+v, want exclusive %v and cow usage %v", size, tt.exclusive, tt.cowUsage)
+			}
+		})
+	}
+}
+
+func TestParseLvmSnapshotID(t *testing.T) {
+	tests := []struct {
+		id    string
+		valid bool
+	}{
+		{"vg0/fs_snapshot_20221001_120000_0a1b2c3d", true},
+		{"vg0/root", false},
+		{"fs_snapshot_20221001_120000_0a1b2c3d", false},
+		{"/fs_snapshot_20221001_120000_0a1b2c3d", false},
+		{"vg0/fs_snapshot_x/y", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			_, _, err := parseLvmSnapshotID(tt.id)
+			if (err == nil) != tt.valid {
+				t.Errorf("parseLvmSnapshotID(%q) error = %v, want valid %v", tt.id, err, tt.valid)
+			}
+		})
+	}
+}
+
+func TestNewLvmSnapshotName(t *testing.T) {
+	name, err := newLvmSnapshotName()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err = parseLvmSnapshotID("vg0/" + name)
+	if err != nil {
+		t.Errorf("the name %v is not accepted by the cleanup: %v", name, err)
+	}
+}
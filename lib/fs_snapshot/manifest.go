@@ -0,0 +1,359 @@
+package fs_snapshot
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const manifestVersion = 1
+
+// Manifest has the hashes of all files in a dir, so it can be verified later. The hash of a dir is computed
+// from the hashes, modes and names of its entries (like a Merkle tree), so the Root hash changes if any content
+// changes.
+type Manifest struct {
+	Version      int              `json:"version"`
+	OriginalDir  string           `json:"originalDir"`
+	CreationTime time.Time        `json:"creationTime"`
+	Root         string           `json:"root"`
+	Entries      []*ManifestEntry `json:"entries"`
+}
+
+// ManifestEntry is a file or dir in the manifest. Path is in io/fs format and relative to the original dir.
+// Hash is the SHA-256 of the contents (for files), of the destination (for symbolic links) or of the entries
+// (for dirs). Other file types have no hash.
+type ManifestEntry struct {
+	Path    string      `json:"path"`
+	Size    int64       `json:"size"`
+	Mode    fs.FileMode `json:"mode"`
+	ModTime time.Time   `json:"modTime"`
+	Hash    string      `json:"hash,omitempty"`
+}
+
+type ManifestConfig struct {
+	// Concurrency is the number of files hashed at the same time. Default is the number of CPUs.
+	Concurrency int
+
+	// IgnoreModTime makes VerifyManifest not compare modification times, for trees restored by tools that
+	// don't keep them.
+	IgnoreModTime bool
+
+	InfoCallback InfoMessageCallback
+}
+
+func (cfg *ManifestConfig) setDefaults() {
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = runtime.NumCPU()
+	}
+	if cfg.InfoCallback == nil {
+		cfg.InfoCallback = func(level MessageLevel, format string, a ...interface{}) {}
+	}
+}
+
+// CreateManifest hashes all the files (usually from Backuper.FS or Snapshot.FS, or DirFS for a restored tree).
+func CreateManifest(files FS, originalDir string, cfg *ManifestConfig) (*Manifest, error) {
+	if cfg == nil {
+		cfg = &ManifestConfig{}
+	}
+	cfg.setDefaults()
+
+	result := &Manifest{
+		Version:      manifestVersion,
+		OriginalDir:  originalDir,
+		CreationTime: time.Now(),
+	}
+
+	var toHash []*ManifestEntry
+
+	err := fs.WalkDir(files, ".", func(file string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		entry := &ManifestEntry{
+			Path:    file,
+			Mode:    info.Mode(),
+			ModTime: info.ModTime(),
+		}
+
+		switch {
+		case info.Mode().IsRegular():
+			entry.Size = info.Size()
+			toHash = append(toHash, entry)
+
+		case info.Mode()&fs.ModeSymlink != 0:
+			link, err := files.ReadLink(file)
+			if err != nil {
+				return err
+			}
+
+			entry.Size = int64(len(link))
+			entry.Hash = hashString(link)
+		}
+
+		result.Entries = append(result.Entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.InfoCallback(DetailsLevel, "Hashing %v files", len(toHash))
+
+	err = hashFiles(files, toHash, cfg.Concurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Root = computeDirHashes(result.Entries)
+
+	return result, nil
+}
+
+func hashFiles(files FS, entries []*ManifestEntry, concurrency int) error {
+	var wg sync.WaitGroup
+	var m sync.Mutex
+	var firstErr error
+
+	work := make(chan *ManifestEntry)
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for entry := range work {
+				hash, err := hashFile(files, entry.Path)
+
+				m.Lock()
+				if err != nil && firstErr == nil {
+					firstErr = err
+				}
+				m.Unlock()
+
+				entry.Hash = hash
+			}
+		}()
+	}
+
+	for _, entry := range entries {
+		m.Lock()
+		failed := firstErr != nil
+		m.Unlock()
+
+		if failed {
+			break
+		}
+
+		work <- entry
+	}
+
+	close(work)
+	wg.Wait()
+
+	return firstErr
+}
+
+func hashFile(files FS, name string) (string, error) {
+	f, err := files.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+
+	_, err = io.Copy(h, f)
+	if err != nil {
+		return "", errors.Wrapf(err, "error hashing %v", name)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func hashString(s string) string {
+	h := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(h[:])
+}
+
+// computeDirHashes fills the hashes of the dirs and returns the hash of the root. The entries must be in the
+// fs.WalkDir order.
+func computeDirHashes(entries []*ManifestEntry) string {
+	children := make(map[string][]*ManifestEntry)
+
+	// In reverse, so all the children are computed before their dir
+	for i := len(entries) - 1; i >= 0; i-- {
+		e := entries[i]
+
+		if e.Mode.IsDir() {
+			h := sha256.New()
+
+			// WalkDir returns the entries sorted by name, so they are in reverse order here
+			cs := children[e.Path]
+			for j := len(cs) - 1; j >= 0; j-- {
+				c := cs[j]
+				_, _ = fmt.Fprintf(h, "%o %v %q\n", uint32(c.Mode), c.Hash, path.Base(c.Path))
+			}
+
+			e.Hash = hex.EncodeToString(h.Sum(nil))
+			delete(children, e.Path)
+		}
+
+		if e.Path != "." {
+			parent := path.Dir(e.Path)
+			children[parent] = append(children[parent], e)
+		}
+	}
+
+	if len(entries) == 0 {
+		return ""
+	}
+
+	return entries[0].Hash
+}
+
+// WriteManifest writes the manifest as JSON.
+func WriteManifest(w io.Writer, m *Manifest) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(m)
+}
+
+// ReadManifest reads a manifest written by WriteManifest.
+func ReadManifest(r io.Reader) (*Manifest, error) {
+	var result Manifest
+
+	err := json.NewDecoder(r).Decode(&result)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid manifest")
+	}
+
+	if result.Version != manifestVersion {
+		return nil, errors.Errorf("unsupported manifest version: %v", result.Version)
+	}
+
+	return &result, nil
+}
+
+type ManifestMismatchType int
+
+const (
+	// ManifestMissing is a file in the manifest that does not exist anymore
+	ManifestMissing ManifestMismatchType = iota
+	// ManifestExtra is a file that is not in the manifest
+	ManifestExtra
+	// ManifestChanged is a file that exists in both, but is different
+	ManifestChanged
+)
+
+func (t ManifestMismatchType) String() string {
+	switch t {
+	case ManifestMissing:
+		return "missing"
+	case ManifestExtra:
+		return "extra"
+	case ManifestChanged:
+		return "changed"
+	default:
+		return "unknown"
+	}
+}
+
+type ManifestMismatch struct {
+	Type    ManifestMismatchType
+	Path    string
+	Details string
+}
+
+func (m *ManifestMismatch) String() string {
+	if m.Details == "" {
+		return fmt.Sprintf("%v: %v", m.Type, m.Path)
+	} else {
+		return fmt.Sprintf("%v: %v (%v)", m.Type, m.Path, m.Details)
+	}
+}
+
+// VerifyManifest checks the files against a manifest and returns the differences, sorted by path. Dirs are only
+// reported as changed for differences in themselves (like mode), and not because of changes in their contents.
+func VerifyManifest(files FS, expected *Manifest, cfg *ManifestConfig) ([]*ManifestMismatch, error) {
+	if cfg == nil {
+		cfg = &ManifestConfig{}
+	}
+	cfg.setDefaults()
+
+	actual, err := CreateManifest(files, expected.OriginalDir, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*ManifestMismatch
+
+	if actual.Root == expected.Root && cfg.IgnoreModTime {
+		return result, nil
+	}
+
+	actualByPath := make(map[string]*ManifestEntry, len(actual.Entries))
+	for _, e := range actual.Entries {
+		actualByPath[e.Path] = e
+	}
+
+	for _, e := range expected.Entries {
+		a, ok := actualByPath[e.Path]
+		if !ok {
+			result = append(result, &ManifestMismatch{Type: ManifestMissing, Path: e.Path})
+			continue
+		}
+
+		delete(actualByPath, e.Path)
+
+		details := compareManifestEntries(e, a, cfg)
+		if details != "" {
+			result = append(result, &ManifestMismatch{Type: ManifestChanged, Path: e.Path, Details: details})
+		}
+	}
+
+	for _, e := range actual.Entries {
+		if _, ok := actualByPath[e.Path]; ok {
+			result = append(result, &ManifestMismatch{Type: ManifestExtra, Path: e.Path})
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Path < result[j].Path
+	})
+
+	return result, nil
+}
+
+func compareManifestEntries(expected *ManifestEntry, actual *ManifestEntry, cfg *ManifestConfig) string {
+	switch {
+	case expected.Mode != actual.Mode:
+		return fmt.Sprintf("mode %v != %v", actual.Mode, expected.Mode)
+	case expected.Size != actual.Size:
+		return fmt.Sprintf("size %v != %v", actual.Size, expected.Size)
+	case !expected.Mode.IsDir() && expected.Hash != actual.Hash:
+		return "contents"
+	case !cfg.IgnoreModTime && !expected.ModTime.Equal(actual.ModTime):
+		return fmt.Sprintf("modification time %v != %v",
+			actual.ModTime.Format(time.RFC3339Nano), expected.ModTime.Format(time.RFC3339Nano))
+	default:
+		return ""
+	}
+}
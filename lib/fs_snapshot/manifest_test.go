@@ -0,0 +1,308 @@
+package fs_snapshot
+
+import (
+	"bytes"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testModTime = time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+
+// createTestTree creates the files (with their contents) and sets all modification times to testModTime.
+func createTestTree(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+
+	for name, contents := range files {
+		file := filepath.Join(dir, filepath.FromSlash(name))
+
+		err := os.MkdirAll(filepath.Dir(file), 0o755)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = os.WriteFile(file, []byte(contents), 0o644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resetModTimes(t, dir)
+
+	return dir
+}
+
+func resetModTimes(t *testing.T, dir string) {
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		return os.Chtimes(path, testModTime, testModTime)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func createTestManifest(t *testing.T, dir string) *Manifest {
+	m, err := CreateManifest(DirFS(dir), dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return m
+}
+
+func TestComputeDirHashes(t *testing.T) {
+	base := map[string]string{
+		"a.txt":     "a",
+		"sub/b.txt": "b",
+		"sub/c.txt": "c",
+	}
+
+	root := createTestManifest(t, createTestTree(t, base)).Root
+
+	tests := []struct {
+		name  string
+		files map[string]string
+		same  bool
+	}{
+		{"same contents", base, true},
+		{"file changed", map[string]string{"a.txt": "x", "sub/b.txt": "b", "sub/c.txt": "c"}, false},
+		{"nested file changed", map[string]string{"a.txt": "a", "sub/b.txt": "b", "sub/c.txt": "x"}, false},
+		{"file renamed", map[string]string{"a.txt": "a", "sub/b.txt": "b", "sub/d.txt": "c"}, false},
+		{"file moved", map[string]string{"a.txt": "a", "sub/b.txt": "b", "c.txt": "c"}, false},
+		{"file added", map[string]string{"a.txt": "a", "sub/b.txt": "b", "sub/c.txt": "c", "d.txt": ""}, false},
+		{"file removed", map[string]string{"a.txt": "a", "sub/b.txt": "b"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := createTestManifest(t, createTestTree(t, tt.files)).Root
+
+			if (got == root) != tt.same {
+				t.Errorf("root %v, base root %v, want same = %v", got, root, tt.same)
+			}
+		})
+	}
+}
+
+func TestComputeDirHashesOfEntries(t *testing.T) {
+	entries := []*ManifestEntry{
+		{Path: ".", Mode: fs.ModeDir | 0o755},
+		{Path: "a", Mode: 0o644, Hash: hashString("a")},
+		{Path: "sub", Mode: fs.ModeDir | 0o755},
+		{Path: "sub/b", Mode: 0o644, Hash: hashString("b")},
+	}
+
+	root := computeDirHashes(entries)
+
+	if root != entries[0].Hash {
+		t.Errorf("root %v is not the hash of the first entry %v", root, entries[0].Hash)
+	}
+	if entries[2].Hash == "" {
+		t.Errorf("the hash of sub was not computed")
+	}
+
+	// The mode of the entries is part of the hash of their dir
+	entries[3].Mode = 0o600
+	for _, e := range entries {
+		if e.Mode.IsDir() {
+			e.Hash = ""
+		}
+	}
+
+	if computeDirHashes(entries) == root {
+		t.Errorf("changing the mode of a nested file did not change the root")
+	}
+
+	if computeDirHashes(nil) != "" {
+		t.Errorf("the root of no entries must be empty")
+	}
+}
+
+func TestVerifyManifest(t *testing.T) {
+	type test struct {
+		name          string
+		change        func(t *testing.T, dir string)
+		ignoreModTime bool
+		want          []string
+	}
+
+	tests := []test{
+		{
+			"no changes",
+			func(t *testing.T, dir string) {},
+			false,
+			nil,
+		},
+		{
+			"contents changed with the same size",
+			func(t *testing.T, dir string) {
+				writeTestFile(t, filepath.Join(dir, "sub", "b.txt"), "x")
+			},
+			false,
+			[]string{"changed: sub/b.txt (contents)"},
+		},
+		{
+			"size changed",
+			func(t *testing.T, dir string) {
+				writeTestFile(t, filepath.Join(dir, "a.txt"), "abc")
+			},
+			false,
+			[]string{"changed: a.txt (size 3 != 1)"},
+		},
+		{
+			"file removed",
+			func(t *testing.T, dir string) {
+				removeTestFile(t, filepath.Join(dir, "sub", "c.txt"))
+			},
+			false,
+			[]string{"missing: sub/c.txt"},
+		},
+		{
+			"dir removed",
+			func(t *testing.T, dir string) {
+				removeTestFile(t, filepath.Join(dir, "sub"))
+			},
+			false,
+			[]string{"missing: sub", "missing: sub/b.txt", "missing: sub/c.txt"},
+		},
+		{
+			"file added",
+			func(t *testing.T, dir string) {
+				writeTestFile(t, filepath.Join(dir, "sub", "d.txt"), "d")
+			},
+			false,
+			[]string{"extra: sub/d.txt"},
+		},
+		{
+			"modification time changed",
+			func(t *testing.T, dir string) {
+				mt := testModTime.Add(time.Hour)
+				err := os.Chtimes(filepath.Join(dir, "a.txt"), mt, mt)
+				if err != nil {
+					t.Fatal(err)
+				}
+			},
+			false,
+			[]string{"changed: a.txt (modification time 2022-10-01T13:00:00Z != 2022-10-01T12:00:00Z)"},
+		},
+		{
+			"modification time ignored",
+			func(t *testing.T, dir string) {
+				mt := testModTime.Add(time.Hour)
+				err := os.Chtimes(filepath.Join(dir, "a.txt"), mt, mt)
+				if err != nil {
+					t.Fatal(err)
+				}
+			},
+			true,
+			nil,
+		},
+		{
+			"contents changed ignoring modification time",
+			func(t *testing.T, dir string) {
+				writeTestFile(t, filepath.Join(dir, "a.txt"), "x")
+			},
+			true,
+			[]string{"changed: a.txt (contents)"},
+		},
+	}
+
+	if runtime.GOOS != "windows" {
+		tests = append(tests, test{
+			"mode changed",
+			func(t *testing.T, dir string) {
+				err := os.Chmod(filepath.Join(dir, "a.txt"), 0o600)
+				if err != nil {
+					t.Fatal(err)
+				}
+			},
+			false,
+			[]string{"changed: a.txt (mode -rw------- != -rw-r--r--)"},
+		})
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := createTestTree(t, map[string]string{
+				"a.txt":     "a",
+				"sub/b.txt": "b",
+				"sub/c.txt": "c",
+			})
+
+			m := createTestManifest(t, dir)
+
+			tt.change(t, dir)
+
+			// Only the modification times changed on purpose must be reported
+			if !strings.HasPrefix(tt.name, "modification time") {
+				resetModTimes(t, dir)
+			}
+
+			mismatches, err := VerifyManifest(DirFS(dir), m, &ManifestConfig{IgnoreModTime: tt.ignoreModTime})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, mm := range mismatches {
+				got = append(got, mm.String())
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteAndReadManifest(t *testing.T) {
+	dir := createTestTree(t, map[string]string{"a.txt": "a"})
+	m := createTestManifest(t, dir)
+
+	var buf bytes.Buffer
+	err := WriteManifest(&buf, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	read, err := ReadManifest(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mismatches, err := VerifyManifest(DirFS(dir), read, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mismatches) != 0 {
+		t.Errorf("unexpected mismatches: %v", mismatches)
+	}
+
+	_, err = ReadManifest(strings.NewReader(`{"version": 2}`))
+	if err == nil {
+		t.Errorf("expected an error reading an unsupported version")
+	}
+}
+
+func writeTestFile(t *testing.T, file string, contents string) {
+	err := os.WriteFile(file, []byte(contents), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func removeTestFile(t *testing.T, file string) {
+	err := os.RemoveAll(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
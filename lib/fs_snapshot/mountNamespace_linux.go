@@ -0,0 +1,78 @@
+//go:build linux
+
+package fs_snapshot
+
+import (
+	"os/exec"
+	"runtime"
+	"sort"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+// StartWithSnapshotMounts starts the command inside a private mount namespace, where each original dir is
+// bind mounted over (read only) by its snapshot dir. The command sees the snapshot data in the original paths,
+// and nothing changes outside the namespace. The mounts are removed when the command finishes.
+// dirs maps from the original dir to the snapshot dir. Needs root.
+func StartWithSnapshotMounts(cmd *exec.Cmd, dirs map[string]string, infoCb InfoMessageCallback) error {
+	if infoCb == nil {
+		infoCb = func(level MessageLevel, format string, a ...interface{}) {}
+	}
+
+	result := make(chan error)
+
+	go func() {
+		// Never unlocked: the thread is discarded when the goroutine ends, so its namespace doesn't leak to
+		// the rest of the process
+		runtime.LockOSThread()
+
+		result <- startInPrivateMountNamespace(cmd, dirs, infoCb)
+	}()
+
+	return <-result
+}
+
+func startInPrivateMountNamespace(cmd *exec.Cmd, dirs map[string]string, infoCb InfoMessageCallback) error {
+	err := unix.Unshare(unix.CLONE_NEWNS)
+	if err != nil {
+		return errors.Wrap(err, "error creating private mount namespace (it needs root)")
+	}
+
+	// Don't propagate the mounts to the original namespace
+	err = unix.Mount("", "/", "", unix.MS_REC|unix.MS_PRIVATE, "")
+	if err != nil {
+		return errors.Wrap(err, "error making mounts private")
+	}
+
+	// Parents first, so mounts of sub-dirs are not hidden
+	originals := make([]string, 0, len(dirs))
+	for o := range dirs {
+		originals = append(originals, o)
+	}
+	sort.Slice(originals, func(i, j int) bool {
+		return len(originals[i]) < len(originals[j])
+	})
+
+	for _, original := range originals {
+		snapshot := dirs[original]
+		if snapshot == "" || samePath(snapshot, original) {
+			continue
+		}
+
+		infoCb(DetailsLevel, "Mounting %v over %v", snapshot, original)
+
+		err = unix.Mount(snapshot, original, "", unix.MS_BIND|unix.MS_REC, "")
+		if err != nil {
+			return errors.Wrapf(err, "error mounting %v over %v", snapshot, original)
+		}
+
+		err = unix.Mount("", original, "", unix.MS_BIND|unix.MS_REMOUNT|unix.MS_RDONLY, "")
+		if err != nil {
+			return errors.Wrapf(err, "error making %v read only", original)
+		}
+	}
+
+	// The child is created from this thread, so it is inside the namespace
+	return cmd.Start()
+}
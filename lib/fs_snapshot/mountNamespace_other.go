@@ -0,0 +1,14 @@
+//go:build !linux
+
+package fs_snapshot
+
+import (
+	"os/exec"
+
+	"github.com/pkg/errors"
+)
+
+// StartWithSnapshotMounts is only available in Linux.
+func StartWithSnapshotMounts(cmd *exec.Cmd, dirs map[string]string, infoCb InfoMessageCallback) error {
+	return errors.New("private mount namespaces are only available in Linux")
+}
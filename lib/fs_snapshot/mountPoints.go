@@ -1,12 +1,14 @@
 package fs_snapshot
 
 import (
+	"os"
 	"path/filepath"
 	"strings"
 	"sync"
 )
 
 type volumeInfos struct {
+	// caseSensitive is the default, used for volume names and when it can't be detected for a mount point
 	caseSensitive bool
 
 	mutex   sync.RWMutex
@@ -16,9 +18,14 @@ type volumeInfos struct {
 type mountPointInfo struct {
 	dir string
 
+	// Detected on first use, because it needs to read the mount point
+	caseSensitiveOnce sync.Once
+	caseSensitive     bool
+
 	mutex    sync.RWMutex
 	state    mountPointState
 	snapshot *Snapshot
+	err      error // Why it failed, if StateFailed
 }
 
 type mountPointState int
@@ -27,6 +34,10 @@ const (
 	StatePending mountPointState = iota
 	StateSuccess
 	StateFailed
+	// StateInvalidated is a snapshot that was created, but became invalid later
+	StateInvalidated
+	// StateNoSnapshot is a mount point that the provider does not snapshot, without it being an error
+	StateNoSnapshot
 )
 
 func newVolumeInfos(caseSensitive bool) *volumeInfos {
@@ -83,14 +94,9 @@ func (i *volumeInfos) AddVolume(volume string, listMountPoints func(volume strin
 func (i *volumeInfos) ComputeNeeded(dir string) []*mountPointInfo {
 	var result []*mountPointInfo
 
-	volume := i.volumeName(dir)
-
-	i.mutex.RLock()
-	defer i.mutex.RUnlock()
-
-	for _, mount := range i.volumes[volume] {
-		insideMount := i.hasPrefix(dir, mount.dir)
-		mountInside := i.hasPrefix(mount.dir, dir)
+	for _, mount := range i.mountPointsOf(dir) {
+		insideMount := i.hasPrefix(mount, dir, mount.dir)
+		mountInside := i.hasPrefix(mount, mount.dir, dir)
 
 		if !insideMount && !mountInside {
 			continue
@@ -107,15 +113,26 @@ func (i *volumeInfos) ComputeNeeded(dir string) []*mountPointInfo {
 func (i *volumeInfos) GetMountPoint(dir string) *mountPointInfo {
 	var result *mountPointInfo
 
+	for _, m := range i.mountPointsOf(dir) {
+		if i.hasPrefix(m, dir, m.dir) && (result == nil || len(result.dir) < len(m.dir)) {
+			result = m
+		}
+	}
+
+	return result
+}
+
+// mountPointsOf returns the mount points of the volume of dir. They are copied, so they can be compared without
+// holding the lock, because detecting their case sensitivity reads the disk.
+func (i *volumeInfos) mountPointsOf(dir string) []*mountPointInfo {
 	volume := i.volumeName(dir)
 
 	i.mutex.RLock()
 	defer i.mutex.RUnlock()
 
+	result := make([]*mountPointInfo, 0, len(i.volumes[volume]))
 	for _, m := range i.volumes[volume] {
-		if i.hasPrefix(dir, m.dir) && (result == nil || len(result.dir) < len(m.dir)) {
-			result = m
-		}
+		result = append(result, m)
 	}
 
 	return result
@@ -131,11 +148,65 @@ func (i *volumeInfos) volumeName(dir string) string {
 	return result
 }
 
-func (i *volumeInfos) hasPrefix(s, prefix string) bool {
-	if !i.caseSensitive {
-		s = strings.ToLower(s)
-		prefix = strings.ToLower(prefix)
+// isCaseSensitive returns if the file system in the mount point is case-sensitive.
+func (i *volumeInfos) isCaseSensitive(m *mountPointInfo) bool {
+	m.caseSensitiveOnce.Do(func() {
+		m.caseSensitive = detectCaseSensitivity(m.dir, i.caseSensitive)
+	})
+
+	return m.caseSensitive
+}
+
+// hasPrefix uses the case sensitivity of the mount point m. It is only detected if the case matters.
+func (i *volumeInfos) hasPrefix(m *mountPointInfo, s, prefix string) bool {
+	if strings.HasPrefix(s, prefix) {
+		return true
+	}
+
+	if len(s) < len(prefix) || !strings.EqualFold(s[:len(prefix)], prefix) {
+		return false
+	}
+
+	return !i.isCaseSensitive(m)
+}
+
+// samePath uses the case sensitivity of the mount point that contains a.
+func (i *volumeInfos) samePath(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	if !strings.EqualFold(a, b) {
+		return false
+	}
+
+	m := i.GetMountPoint(addPathSeparatorAsSuffix(a))
+	if m == nil {
+		return !i.caseSensitive
+	}
+
+	return !i.isCaseSensitive(m)
+}
+
+// changeBaseDir is like the global changeBaseDir, but uses the case sensitivity of the mount points.
+// Returns false if the path is not inside oldBase.
+func (i *volumeInfos) changeBaseDir(path string, oldBase string, newBase string) (string, bool) {
+	base := addPathSeparatorAsSuffix(oldBase)
+	withSeparator := addPathSeparatorAsSuffix(path)
+
+	if len(withSeparator) < len(base) || !i.samePath(withSeparator[:len(base)], base) {
+		return path, false
+	}
+
+	// Uses the case of the base, so filepath.Rel works in case insensitive volumes
+	result, err := changeBaseDir(base+withSeparator[len(base):], base, newBase)
+	if err != nil {
+		return path, false
+	}
+
+	if strings.HasSuffix(path, string(os.PathSeparator)) {
+		result = addPathSeparatorAsSuffix(result)
 	}
 
-	return strings.HasPrefix(s, prefix)
+	return result, true
 }
@@ -0,0 +1,173 @@
+package fs_snapshot
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+// newTestVolumeInfos creates the mount points (relative to the root) with their case sensitivity, so it is not
+// detected from the disk.
+func newTestVolumeInfos(t *testing.T, mounts map[string]bool) *volumeInfos {
+	i := newVolumeInfos(true)
+
+	volume := filepath.VolumeName(testPath(""))
+
+	err := i.AddVolume(volume, func(volume string) ([]string, error) {
+		var result []string
+		for m := range mounts {
+			result = append(result, addPathSeparatorAsSuffix(testPath(m)))
+		}
+		return result, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for m, caseSensitive := range mounts {
+		info := i.volumes[i.volumeName(volume)][addPathSeparatorAsSuffix(testPath(m))]
+		cs := caseSensitive
+		info.caseSensitiveOnce.Do(func() {
+			info.caseSensitive = cs
+		})
+	}
+
+	return i
+}
+
+func TestVolumeInfosGetMountPoint(t *testing.T) {
+	i := newTestVolumeInfos(t, map[string]bool{
+		"":           true,
+		"data":       false,
+		"data/inner": true,
+	})
+
+	tests := []struct {
+		dir  string
+		want string
+	}{
+		{"home/user", ""},
+		{"data/photos", "data"},
+		{"DATA/photos", "data"},
+		{"data/inner/a", "data/inner"},
+		{"DATA/inner/a", "data"},
+		{"data/INNER/a", "data"},
+		{"database", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dir, func(t *testing.T) {
+			m := i.GetMountPoint(addPathSeparatorAsSuffix(testPath(tt.dir)))
+			if m == nil {
+				t.Fatalf("no mount point found")
+			}
+
+			want := addPathSeparatorAsSuffix(testPath(tt.want))
+			if m.dir != want {
+				t.Errorf("GetMountPoint(%q) = %v, want %v", tt.dir, m.dir, want)
+			}
+		})
+	}
+}
+
+func TestVolumeInfosHasPrefix(t *testing.T) {
+	i := newTestVolumeInfos(t, map[string]bool{
+		"":     true,
+		"data": false,
+	})
+
+	tests := []struct {
+		mount  string
+		s      string
+		prefix string
+		want   bool
+	}{
+		{"", "home/user/a", "home/user", true},
+		{"", "home/User/a", "home/user", false},
+		{"", "home", "home/user", false},
+		{"data", "data/photos/a", "data/photos", true},
+		{"data", "data/Photos/a", "data/photos", true},
+		{"data", "DATA/PHOTOS", "data/photos", true},
+		{"data", "data/videos/a", "data/photos", false},
+		{"data", "data", "data/photos", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s+" "+tt.prefix, func(t *testing.T) {
+			m := i.GetMountPoint(addPathSeparatorAsSuffix(testPath(tt.mount)))
+
+			got := i.hasPrefix(m, testPath(tt.s), testPath(tt.prefix))
+			if got != tt.want {
+				t.Errorf("hasPrefix(%q, %q) = %v, want %v", tt.s, tt.prefix, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolumeInfosSamePath(t *testing.T) {
+	i := newTestVolumeInfos(t, map[string]bool{
+		"":     true,
+		"data": false,
+	})
+
+	tests := []struct {
+		a    string
+		b    string
+		want bool
+	}{
+		{"home/user", "home/user", true},
+		{"home/user", "home/User", false},
+		{"data/photos", "data/Photos", true},
+		{"data/photos", "DATA/PHOTOS", true},
+		{"data/photos", "data/videos", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+" "+tt.b, func(t *testing.T) {
+			got := i.samePath(testPath(tt.a), testPath(tt.b))
+			if got != tt.want {
+				t.Errorf("samePath(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVolumeInfosChangeBaseDir(t *testing.T) {
+	i := newTestVolumeInfos(t, map[string]bool{
+		"":     true,
+		"data": false,
+	})
+
+	tests := []struct {
+		name    string
+		path    string
+		oldBase string
+		newBase string
+		want    string
+		ok      bool
+	}{
+		{"inside", testPath("home/user/a.txt"), testPath("home"), testPath("snap"), testPath("snap/user/a.txt"), true},
+		{"the base", testPath("home"), testPath("home"), testPath("snap"), testPath("snap"), true},
+		{"keeps the separator", addPathSeparatorAsSuffix(testPath("home/user")), testPath("home"), testPath("snap"),
+			addPathSeparatorAsSuffix(testPath("snap/user")), true},
+		{"base with separator", testPath("home/user"), addPathSeparatorAsSuffix(testPath("home")), testPath("snap"),
+			testPath("snap/user"), true},
+		{"other case in case-sensitive", testPath("Home/user"), testPath("home"), testPath("snap"),
+			testPath("Home/user"), false},
+		{"outside", testPath("srv/a"), testPath("home"), testPath("snap"), testPath("srv/a"), false},
+		{"same prefix", testPath("homes/a"), testPath("home"), testPath("snap"), testPath("homes/a"), false},
+		{"other case in case-insensitive", testPath("DATA/Photos/A.jpg"), testPath("data/photos"), testPath("snap"),
+			testPath("snap/A.jpg"), true},
+		{"other case of the base", testPath("Data/Photos"), testPath("data/photos"), testPath("snap"),
+			testPath("snap"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := i.changeBaseDir(tt.path, tt.oldBase, tt.newBase)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("changeBaseDir(%q, %q, %q) = (%q, %v), want (%q, %v)",
+					tt.path, tt.oldBase, tt.newBase, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
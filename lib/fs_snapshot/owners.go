@@ -0,0 +1,134 @@
+package fs_snapshot
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// snapshotOwners records which user created each snapshot through the server.
+// Snapshots not in it were created by someone else (or before the server started recording them).
+type snapshotOwners struct {
+	path string
+
+	mutex  sync.Mutex
+	owners map[string]*snapshotOwner
+}
+
+type snapshotOwner struct {
+	Username     string    `json:"username"`
+	CreationTime time.Time `json:"creationTime"`
+}
+
+// loadSnapshotOwners always returns an usable store, even if there is an error reading the file.
+func loadSnapshotOwners(path string) (*snapshotOwners, error) {
+	result := &snapshotOwners{
+		path:   path,
+		owners: map[string]*snapshotOwner{},
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return result, nil
+	}
+	if err != nil {
+		return result, errors.Wrapf(err, "error reading snapshot owners %v", path)
+	}
+
+	err = json.Unmarshal(data, &result.owners)
+	if err != nil {
+		result.owners = map[string]*snapshotOwner{}
+		return result, errors.Wrapf(err, "error parsing snapshot owners %v", path)
+	}
+
+	return result, nil
+}
+
+func (o *snapshotOwners) Add(snapshotID string, username string) error {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if e, ok := o.owners[snapshotID]; ok && e.Username == username {
+		return nil
+	}
+
+	o.owners[snapshotID] = &snapshotOwner{
+		Username:     username,
+		CreationTime: time.Now(),
+	}
+
+	return o.write()
+}
+
+func (o *snapshotOwners) IsOwner(snapshotID string, username string) bool {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	e, ok := o.owners[snapshotID]
+	return ok && e.Username == username
+}
+
+// Count returns the number of existing snapshots owned by the user.
+func (o *snapshotOwners) Count(existing []*Snapshot, username string) int {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	result := 0
+	for _, s := range existing {
+		if e, ok := o.owners[s.ID]; ok && e.Username == username {
+			result++
+		}
+	}
+
+	return result
+}
+
+// RemoveMissing forgets the snapshots that do not exist anymore.
+func (o *snapshotOwners) RemoveMissing(existing []*Snapshot) error {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	ids := make(map[string]bool, len(existing))
+	for _, s := range existing {
+		ids[s.ID] = true
+	}
+
+	changed := false
+	for id := range o.owners {
+		if !ids[id] {
+			delete(o.owners, id)
+			changed = true
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+
+	return o.write()
+}
+
+func (o *snapshotOwners) write() error {
+	err := os.MkdirAll(filepath.Dir(o.path), 0o700)
+	if err != nil {
+		return errors.Wrapf(err, "error writing snapshot owners %v", o.path)
+	}
+
+	data, err := json.MarshalIndent(o.owners, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp := o.path + ".tmp"
+
+	err = os.WriteFile(tmp, data, 0o600)
+	if err != nil {
+		return errors.Wrapf(err, "error writing snapshot owners %v", o.path)
+	}
+
+	return os.Rename(tmp, o.path)
+}
@@ -0,0 +1,255 @@
+package fs_snapshot
+
+import (
+	"encoding/json"
+	"os"
+	"os/user"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Policy says what each user can do in the server. It is loaded from a JSON file in the format:
+//
+//	{
+//	  "rules": [
+//	    { "users": ["root"], "listOthersSnapshots": true, "deleteOthersSnapshots": true },
+//	    { "groups": ["backup"], "dirs": ["/home", "/srv"], "maxConcurrentBackupers": 2, "maxSnapshots": 10 }
+//	  ]
+//	}
+//
+// The first rule that matches the caller is used. If no rule matches, the caller can't do anything.
+type Policy struct {
+	Rules []*PolicyRule `json:"rules"`
+}
+
+type PolicyRule struct {
+	// Users this rule applies to. "*" matches any user, including anonymous ones.
+	Users []string `json:"users,omitempty"`
+
+	// Groups this rule applies to.
+	Groups []string `json:"groups,omitempty"`
+
+	// Dirs that can be snapshoted, including sub-dirs. Empty allows any dir.
+	// The dirs are compared after resolving symbolic links.
+	// When reading snapshot files through the server, only the files inside these dirs can be read.
+	Dirs []string `json:"dirs,omitempty"`
+
+	// ListOthersSnapshots allows to list snapshots created by other users or outside the server.
+	ListOthersSnapshots bool `json:"listOthersSnapshots,omitempty"`
+
+	// DeleteOthersSnapshots allows to delete snapshots created by other users or outside the server.
+	DeleteOthersSnapshots bool `json:"deleteOthersSnapshots,omitempty"`
+
+	// MaxConcurrentBackupers is the max number of backupers the user can have open at the same time. 0 means no limit.
+	MaxConcurrentBackupers int `json:"maxConcurrentBackupers,omitempty"`
+
+	// MaxSnapshots is the max number of snapshots created by the user that can exist at the same time,
+	// including the ones that were not deleted after the backup finished. 0 means no limit.
+	MaxSnapshots int `json:"maxSnapshots,omitempty"`
+}
+
+// allowAllRule is used when the server has no policy.
+var allowAllRule = &PolicyRule{
+	Users:                 []string{"*"},
+	ListOthersSnapshots:   true,
+	DeleteOthersSnapshots: true,
+}
+
+func LoadPolicy(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading policy %v", path)
+	}
+
+	result := &Policy{}
+
+	err = json.Unmarshal(data, result)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing policy %v", path)
+	}
+
+	err = result.validate()
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid policy %v", path)
+	}
+
+	return result, nil
+}
+
+func (p *Policy) validate() error {
+	for i, r := range p.Rules {
+		if len(r.Users) == 0 && len(r.Groups) == 0 {
+			return errors.Errorf("rule %v: no users or groups", i+1)
+		}
+		if r.MaxConcurrentBackupers < 0 {
+			return errors.Errorf("rule %v: invalid maxConcurrentBackupers: %v", i+1, r.MaxConcurrentBackupers)
+		}
+		if r.MaxSnapshots < 0 {
+			return errors.Errorf("rule %v: invalid maxSnapshots: %v", i+1, r.MaxSnapshots)
+		}
+
+		for j, d := range r.Dirs {
+			if !filepath.IsAbs(d) {
+				return errors.Errorf("rule %v: dir must be absolute: %v", i+1, d)
+			}
+
+			// The dirs requested are compared after resolving symbolic links, so these must be resolved too
+			if real, err := realPath(d); err == nil {
+				d = real
+			}
+
+			r.Dirs[j] = filepath.Clean(d)
+		}
+	}
+
+	return nil
+}
+
+// RuleFor returns the rule that applies to an user, or nil if there is none.
+func (p *Policy) RuleFor(username string) *PolicyRule {
+	if p == nil {
+		return allowAllRule
+	}
+
+	var groups []string
+	groupsLoaded := false
+
+	for _, r := range p.Rules {
+		for _, u := range r.Users {
+			if u == "*" || (username != "" && u == username) {
+				return r
+			}
+		}
+
+		if len(r.Groups) == 0 || username == "" {
+			continue
+		}
+
+		if !groupsLoaded {
+			groups = listUserGroups(username)
+			groupsLoaded = true
+		}
+
+		for _, rg := range r.Groups {
+			for _, g := range groups {
+				if rg == g {
+					return r
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// listUserGroups returns the names and ids of the groups of an user.
+func listUserGroups(username string) []string {
+	u, err := user.Lookup(username)
+	if err != nil {
+		return nil
+	}
+
+	ids, err := u.GroupIds()
+	if err != nil {
+		return nil
+	}
+
+	result := make([]string, 0, 2*len(ids))
+	for _, id := range ids {
+		result = append(result, id)
+
+		g, err := user.LookupGroupId(id)
+		if err == nil {
+			result = append(result, g.Name)
+		}
+	}
+
+	return result
+}
+
+// AllowsDir returns true if the dir can be snapshoted. The dir must be absolute.
+// A dir is allowed only if it is inside one of the allowed dirs.
+func (r *PolicyRule) AllowsDir(dir string) bool {
+	return r.allowsDir(dir, nil)
+}
+
+// AllowsRead returns true if the contents of a path can be read from a snapshot. The path must be absolute
+// and in the original volume. It is the same as AllowsDir: only paths inside the allowed dirs can be read.
+func (r *PolicyRule) AllowsRead(path string) bool {
+	return r.AllowsDir(path)
+}
+
+// AllowsTraversal returns true if a path is a parent of one of the allowed dirs. These can be listed (only
+// showing the allowed entries) to get to the allowed dirs.
+func (r *PolicyRule) AllowsTraversal(path string) bool {
+	return r.allowsTraversal(path, nil)
+}
+
+// pathComparer compares paths using the case sensitivity of their mount points.
+type pathComparer interface {
+	isSameOrInside(path, dir string) bool
+}
+
+// allowsDir is AllowsDir comparing the paths with paths, or with the default of the OS if it is nil.
+func (r *PolicyRule) allowsDir(dir string, paths pathComparer) bool {
+	if len(r.Dirs) == 0 {
+		return true
+	}
+
+	dir = filepath.Clean(dir)
+
+	for _, d := range r.Dirs {
+		if isSameOrInside(paths, dir, d) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *PolicyRule) allowsRead(path string, paths pathComparer) bool {
+	return r.allowsDir(path, paths)
+}
+
+func (r *PolicyRule) allowsTraversal(path string, paths pathComparer) bool {
+	if len(r.Dirs) == 0 {
+		return true
+	}
+
+	path = filepath.Clean(path)
+
+	for _, d := range r.Dirs {
+		if isSameOrInside(paths, d, path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isSameOrInside(paths pathComparer, path, dir string) bool {
+	if paths != nil {
+		return paths.isSameOrInside(path, dir)
+	}
+
+	return samePath(path, dir) || hasPathPrefix(path, addPathSeparatorAsSuffix(dir))
+}
+
+func samePath(a, b string) bool {
+	if runtime.GOOS == "windows" {
+		return strings.EqualFold(a, b)
+	}
+
+	return a == b
+}
+
+func hasPathPrefix(path, prefix string) bool {
+	if len(path) < len(prefix) {
+		return false
+	}
+
+	return samePath(path[:len(prefix)], prefix)
+}
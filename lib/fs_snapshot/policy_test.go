@@ -0,0 +1,98 @@
+package fs_snapshot
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func testPath(path string) string {
+	root := "/"
+	if runtime.GOOS == "windows" {
+		root = `C:\`
+	}
+
+	return filepath.Join(root, filepath.FromSlash(path))
+}
+
+func TestPolicyRuleDirs(t *testing.T) {
+	rule := &PolicyRule{Dirs: []string{testPath("home/user"), testPath("srv")}}
+
+	tests := []struct {
+		name      string
+		path      string
+		dir       bool
+		read      bool
+		traversal bool
+	}{
+		{"allowed dir", "home/user", true, true, true},
+		{"inside allowed dir", "home/user/docs", true, true, false},
+		{"other allowed dir", "srv/data", true, true, false},
+		{"parent of allowed dir", "home", false, false, true},
+		{"mount point of allowed dir", "", false, false, true},
+		{"sibling of allowed dir", "home/other", false, false, false},
+		{"same prefix", "home/username", false, false, false},
+		{"unrelated dir", "etc", false, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := testPath(tt.path)
+
+			if got := rule.AllowsDir(path); got != tt.dir {
+				t.Errorf("AllowsDir(%v) = %v, want %v", path, got, tt.dir)
+			}
+			if got := rule.AllowsRead(path); got != tt.read {
+				t.Errorf("AllowsRead(%v) = %v, want %v", path, got, tt.read)
+			}
+			if got := rule.AllowsTraversal(path); got != tt.traversal {
+				t.Errorf("AllowsTraversal(%v) = %v, want %v", path, got, tt.traversal)
+			}
+		})
+	}
+}
+
+func TestPolicyRuleWithoutDirsAllowsAll(t *testing.T) {
+	rule := &PolicyRule{}
+	path := testPath("etc")
+
+	if !rule.AllowsDir(path) || !rule.AllowsRead(path) || !rule.AllowsTraversal(path) {
+		t.Errorf("a rule without dirs must allow %v", path)
+	}
+}
+
+func TestPolicyRuleDirsCaseSensitivity(t *testing.T) {
+	// The data mount point is case-insensitive and the root case-sensitive
+	b := newTestBackuper(t)
+	rule := &PolicyRule{Dirs: []string{testPath("data/photos"), testPath("home/user")}}
+
+	tests := []struct {
+		path      string
+		dir       bool
+		traversal bool
+	}{
+		{"data/photos", true, true},
+		{"DATA/Photos/2022", true, false},
+		{"Data", false, true},
+		{"data/videos", false, false},
+		{"home/user/docs", true, false},
+		{"home/User/docs", false, false},
+		{"Home", false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			path := testPath(tt.path)
+
+			if got := rule.allowsDir(path, b); got != tt.dir {
+				t.Errorf("allowsDir(%v) = %v, want %v", path, got, tt.dir)
+			}
+			if got := rule.allowsRead(path, b); got != tt.dir {
+				t.Errorf("allowsRead(%v) = %v, want %v", path, got, tt.dir)
+			}
+			if got := rule.allowsTraversal(path, b); got != tt.traversal {
+				t.Errorf("allowsTraversal(%v) = %v, want %v", path, got, tt.traversal)
+			}
+		})
+	}
+}
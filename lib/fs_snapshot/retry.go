@@ -0,0 +1,126 @@
+package fs_snapshot
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// RetryConfig configures how many times a backuper tries to create a snapshot.
+type RetryConfig struct {
+	// Attempts is the maximum number of times to try to create a snapshot. Default is 3.
+	Attempts int
+
+	// Backoff is the time to wait before the second attempt. It doubles after each attempt, up to MaxBackoff.
+	// Default is 5 seconds.
+	Backoff time.Duration
+
+	// MaxBackoff is the maximum time to wait between attempts. Default is 1 minute.
+	MaxBackoff time.Duration
+
+	// RetryAllErrors retries all errors, instead of only the ones that are known to be transient
+	// (see IsTransientError).
+	RetryAllErrors bool
+}
+
+func (cfg *RetryConfig) setDefaults() {
+	if cfg.Attempts <= 0 {
+		cfg.Attempts = 3
+	}
+	if cfg.Backoff <= 0 {
+		cfg.Backoff = 5 * time.Second
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = time.Minute
+	}
+	if cfg.MaxBackoff < cfg.Backoff {
+		cfg.MaxBackoff = cfg.Backoff
+	}
+}
+
+// nextBackoff returns the time to wait before the attempt after the one that waited backoff.
+func (cfg *RetryConfig) nextBackoff(backoff time.Duration) time.Duration {
+	backoff *= 2
+	if backoff > cfg.MaxBackoff {
+		backoff = cfg.MaxBackoff
+	}
+
+	return backoff
+}
+
+func (cfg *RetryConfig) isRetryable(err error) bool {
+	if errors.Is(err, ErrNotEnoughFreeSpace) {
+		return false
+	}
+
+	return cfg.RetryAllErrors || IsTransientError(err)
+}
+
+// IsTransientError returns true for errors that may not happen again if the snapshot creation is retried later,
+// like VSS failing because another snapshot is being created at the same time.
+func IsTransientError(err error) bool {
+	return isTransientErrorForOS(err)
+}
+
+// FailurePolicy is what a backuper does when a snapshot can't be created.
+type FailurePolicy int
+
+const (
+	// FailurePolicyFallback returns the original directory together with the error, so it can be used instead of
+	// the snapshot.
+	FailurePolicyFallback FailurePolicy = iota
+	// FailurePolicySkip returns the error without a directory. The error wraps ErrDirectorySkipped.
+	FailurePolicySkip
+	// FailurePolicyAbort returns the error without a directory, and all later calls fail. The errors wrap
+	// ErrBackupAborted.
+	FailurePolicyAbort
+)
+
+func ParseFailurePolicy(policy string) (FailurePolicy, error) {
+	switch strings.ToLower(policy) {
+	case "", "fallback":
+		return FailurePolicyFallback, nil
+	case "skip":
+		return FailurePolicySkip, nil
+	case "abort":
+		return FailurePolicyAbort, nil
+	default:
+		return FailurePolicyFallback, errors.Errorf("unknown failure policy: %v", policy)
+	}
+}
+
+func (p FailurePolicy) String() string {
+	switch p {
+	case FailurePolicyFallback:
+		return "fallback"
+	case FailurePolicySkip:
+		return "skip"
+	case FailurePolicyAbort:
+		return "abort"
+	default:
+		return "unknown"
+	}
+}
+
+var ErrDirectorySkipped = errors.New("directory skipped because its snapshot could not be created")
+var ErrBackupAborted = errors.New("backup aborted because a snapshot could not be created")
+
+// failurePolicyError is an error that made the failure policy be applied. errors.Is works with both the policy
+// error (like ErrDirectorySkipped) and the original error.
+type failurePolicyError struct {
+	policyErr error
+	cause     error
+}
+
+func (e *failurePolicyError) Error() string {
+	return e.policyErr.Error() + ": " + e.cause.Error()
+}
+
+func (e *failurePolicyError) Is(target error) bool {
+	return target == e.policyErr
+}
+
+func (e *failurePolicyError) Unwrap() error {
+	return e.cause
+}
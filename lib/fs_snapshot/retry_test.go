@@ -0,0 +1,203 @@
+package fs_snapshot
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestRetryConfigDefaults(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  RetryConfig
+		want RetryConfig
+	}{
+		{"empty", RetryConfig{}, RetryConfig{Attempts: 3, Backoff: 5 * time.Second, MaxBackoff: time.Minute}},
+		{"negative", RetryConfig{Attempts: -1, Backoff: -1, MaxBackoff: -1},
+			RetryConfig{Attempts: 3, Backoff: 5 * time.Second, MaxBackoff: time.Minute}},
+		{"set", RetryConfig{Attempts: 5, Backoff: time.Second, MaxBackoff: 10 * time.Second},
+			RetryConfig{Attempts: 5, Backoff: time.Second, MaxBackoff: 10 * time.Second}},
+		{"max smaller than backoff", RetryConfig{Backoff: 2 * time.Minute},
+			RetryConfig{Attempts: 3, Backoff: 2 * time.Minute, MaxBackoff: 2 * time.Minute}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := tt.cfg
+			cfg.setDefaults()
+
+			if cfg != tt.want {
+				t.Errorf("got %+v, want %+v", cfg, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryConfigNextBackoff(t *testing.T) {
+	cfg := &RetryConfig{Backoff: 5 * time.Second, MaxBackoff: 30 * time.Second}
+
+	tests := []struct {
+		backoff time.Duration
+		want    time.Duration
+	}{
+		{5 * time.Second, 10 * time.Second},
+		{10 * time.Second, 20 * time.Second},
+		{20 * time.Second, 30 * time.Second},
+		{30 * time.Second, 30 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.backoff.String(), func(t *testing.T) {
+			if got := cfg.nextBackoff(tt.backoff); got != tt.want {
+				t.Errorf("nextBackoff(%v) = %v, want %v", tt.backoff, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryConfigIsRetryable(t *testing.T) {
+	errOther := errors.New("other error")
+
+	tests := []struct {
+		name           string
+		retryAllErrors bool
+		err            error
+		want           bool
+	}{
+		{"other error", false, errOther, false},
+		{"other error retrying all", true, errOther, true},
+		{"not enough free space", false, ErrNotEnoughFreeSpace, false},
+		{"not enough free space retrying all", true, ErrNotEnoughFreeSpace, false},
+		{"wrapped not enough free space retrying all", true, errors.Wrap(ErrNotEnoughFreeSpace, "/home"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &RetryConfig{RetryAllErrors: tt.retryAllErrors}
+
+			if got := cfg.isRetryable(tt.err); got != tt.want {
+				t.Errorf("isRetryable(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateSnapshotWithRetries(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	tests := []struct {
+		name           string
+		retryAllErrors bool
+		failures       int
+		wantCalls      int
+		wantErr        bool
+	}{
+		{"success", true, 0, 1, false},
+		{"success after failures", true, 2, 3, false},
+		{"all attempts fail", true, 5, 3, true},
+		{"not retryable", false, 5, 1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+
+			b := &baseBackuper{
+				infoCallback: func(level MessageLevel, format string, a ...interface{}) {},
+				retry: &RetryConfig{
+					Attempts:       3,
+					Backoff:        time.Millisecond,
+					MaxBackoff:     time.Millisecond,
+					RetryAllErrors: tt.retryAllErrors,
+				},
+				createSnapshot: func(m *mountPointInfo, dir string) (*Snapshot, error) {
+					calls++
+					if calls <= tt.failures {
+						return nil, errFailed
+					}
+					return &Snapshot{ID: "id"}, nil
+				},
+			}
+
+			snapshot, err := b.createSnapshotWithRetries(&mountPointInfo{dir: "/"}, "/")
+
+			if calls != tt.wantCalls {
+				t.Errorf("createSnapshot called %v times, want %v", calls, tt.wantCalls)
+			}
+			if tt.wantErr && (err == nil || snapshot != nil) {
+				t.Errorf("expected an error, got %v", snapshot)
+			}
+			if !tt.wantErr && (err != nil || snapshot == nil) {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestParseFailurePolicy(t *testing.T) {
+	tests := []struct {
+		policy  string
+		want    FailurePolicy
+		wantErr bool
+	}{
+		{"", FailurePolicyFallback, false},
+		{"fallback", FailurePolicyFallback, false},
+		{"skip", FailurePolicySkip, false},
+		{"Abort", FailurePolicyAbort, false},
+		{"ignore", FailurePolicyFallback, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.policy, func(t *testing.T) {
+			got, err := ParseFailurePolicy(tt.policy)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseFailurePolicy(%q) error = %v, want error %v", tt.policy, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseFailurePolicy(%q) = %v, want %v", tt.policy, got, tt.want)
+			}
+			if !tt.wantErr && tt.policy != "" && !strings.EqualFold(got.String(), tt.policy) {
+				t.Errorf("String() = %v, want %v", got.String(), tt.policy)
+			}
+		})
+	}
+}
+
+func TestApplyFailurePolicy(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	tests := []struct {
+		policy    FailurePolicy
+		wantDir   string
+		wantIs    error
+		wantAbort bool
+	}{
+		{FailurePolicyFallback, "/home", nil, false},
+		{FailurePolicySkip, "", ErrDirectorySkipped, false},
+		{FailurePolicyAbort, "", ErrBackupAborted, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.policy.String(), func(t *testing.T) {
+			b := &baseBackuper{failurePolicy: tt.policy}
+
+			dir, snapshot, err := b.applyFailurePolicy("/home", errFailed)
+
+			if dir != tt.wantDir || snapshot != nil {
+				t.Errorf("got (%q, %v), want (%q, nil)", dir, snapshot, tt.wantDir)
+			}
+			if !errors.Is(err, errFailed) {
+				t.Errorf("the error %v does not wrap the original error", err)
+			}
+			if tt.wantIs != nil && !errors.Is(err, tt.wantIs) {
+				t.Errorf("the error %v does not wrap %v", err, tt.wantIs)
+			}
+			if (b.abortErr != nil) != tt.wantAbort {
+				t.Errorf("abortErr = %v, want set %v", b.abortErr, tt.wantAbort)
+			}
+		})
+	}
+}
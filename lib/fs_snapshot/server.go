@@ -2,19 +2,35 @@ package fs_snapshot
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
+	"io"
+	"io/fs"
+	"math"
 	"net"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 
-	"github.com/pescuma/go-fs-snapshot/lib/fs_snapshot/internal/rpc"
+	rpc "github.com/pescuma/go-fs-snapshot/lib/fs_snapshot/api/v1"
 )
 
 const DefaultIP = "localhost"
 const DefaultPort = 33721
+const DefaultLeaseTime = time.Minute
 
 func StartServer(snapshoter Snapshoter, cfg *ServerConfig) error {
 	if cfg == nil {
@@ -22,27 +38,171 @@ func StartServer(snapshoter Snapshoter, cfg *ServerConfig) error {
 	}
 	cfg.setDefaults()
 
-	lis, err := net.Listen("tcp", cfg.Address())
+	listeners := make([]net.Listener, 0, len(cfg.Listen))
+	defer func() {
+		for _, lis := range listeners {
+			_ = lis.Close()
+		}
+	}()
+
+	for _, endpoint := range cfg.Listen {
+		lis, err := listen(endpoint)
+		if err != nil {
+			return errors.Wrapf(err, "failed to listen to %v", endpoint)
+		}
+
+		listeners = append(listeners, lis)
+	}
+
+	cleanupTemporarySnapshots(snapshoter, cfg.InfoCallback)
+
+	auth, err := newServerAuthenticator(cfg.Auth, cfg.CredentialsDir)
+	if err != nil {
+		return err
+	}
+
+	creds, err := auth.TransportCredentials()
+	if err != nil {
+		return err
+	}
+
+	unaryInterceptors := []grpc.UnaryServerInterceptor{auth.unaryInterceptor}
+	streamInterceptors := []grpc.StreamServerInterceptor{auth.streamInterceptor}
+
+	var audit *auditLog
+	if cfg.AuditLog != "" || cfg.AuditSyslog {
+		audit, err = newAuditLog(cfg.AuditLog, cfg.AuditSyslog, cfg.InfoCallback)
+		if err != nil {
+			return err
+		}
+		defer audit.Close()
+
+		// Must be the first one to also audit the requests that fail authentication
+		unaryInterceptors = append([]grpc.UnaryServerInterceptor{audit.unaryInterceptor}, unaryInterceptors...)
+		streamInterceptors = append([]grpc.StreamServerInterceptor{audit.streamInterceptor}, streamInterceptors...)
+	}
+
+	opts := []grpc.ServerOption{
+		grpc.Creds(creds),
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	}
+
+	var policy *Policy
+	if cfg.PolicyFile != "" {
+		policy, err = LoadPolicy(cfg.PolicyFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	owners, err := loadSnapshotOwners(filepath.Join(privateDir(cfg.CredentialsDir), "snapshot_owners.json"))
 	if err != nil {
-		return errors.Wrapf(err, "failed to listen to %v", cfg.Address())
+		cfg.InfoCallback(InfoLevel, "Error loading snapshot owners: %v", err)
+	}
+
+	if existing, err := snapshoter.ListSnapshots(""); err == nil {
+		err = owners.RemoveMissing(existing)
+		if err != nil {
+			cfg.InfoCallback(InfoLevel, "Error updating snapshot owners: %v", err)
+		}
 	}
 
-	s := grpc.NewServer()
+	s := grpc.NewServer(opts...)
 
-	rpc.RegisterFsSnapshotServer(s, &server{
+	fss := &server{
 		snapshoter:   snapshoter,
+		version:      cfg.Version,
+		health:       newHealthChecker(cfg.HealthCheckInterval, cfg.InfoCallback),
+		policy:       policy,
+		owners:       owners,
+		audit:        audit,
 		backupers:    map[uint32]*backuper{},
+		leaseTime:    cfg.LeaseTime,
 		activityChan: handleInactivity(s, cfg),
 		infoCallback: cfg.InfoCallback,
-	})
+	}
 
-	cfg.InfoCallback(OutputLevel, "fs_snapshot server listening at: %v", lis.Addr())
+	rpc.RegisterFsSnapshotServer(s, fss)
 
-	if err = s.Serve(lis); err != nil {
-		return err
+	// Older clients use the service from before the API was public
+	legacy := rpc.FsSnapshot_ServiceDesc
+	legacy.ServiceName = legacyServiceName
+	s.RegisterService(&legacy, fss)
+
+	healthpb.RegisterHealthServer(s, fss.health.server)
+	stopHealth := fss.health.Start()
+	defer stopHealth()
+
+	if cfg.Reflection {
+		reflection.Register(s)
 	}
 
-	return nil
+	var httpServer *http.Server
+	var httpListener net.Listener
+	if cfg.HTTPAddress != "" {
+		httpListener, err = net.Listen("tcp", cfg.HTTPAddress)
+		if err != nil {
+			return errors.Wrapf(err, "failed to listen to %v", cfg.HTTPAddress)
+		}
+
+		if auth.mode == AuthTLS {
+			tlsConfig, err := auth.TLSConfig()
+			if err != nil {
+				_ = httpListener.Close()
+				return err
+			}
+
+			httpListener = tls.NewListener(httpListener, tlsConfig)
+		}
+
+		httpServer = &http.Server{
+			Handler:           newHTTPGateway(fss, unaryInterceptors, streamInterceptors),
+			ReadHeaderTimeout: time.Minute,
+		}
+	}
+
+	stopLeases := fss.handleLeases()
+	defer stopLeases()
+
+	for _, lis := range listeners {
+		cfg.InfoCallback(OutputLevel, "fs_snapshot server listening at: %v:%v (authentication: %v)",
+			lis.Addr().Network(), lis.Addr(), auth.mode)
+	}
+	if httpServer != nil {
+		cfg.InfoCallback(OutputLevel, "fs_snapshot HTTP server listening at: %v (authentication: %v)",
+			httpListener.Addr(), auth.mode)
+	}
+
+	count := len(listeners)
+	errs := make(chan error, count+1)
+	for _, lis := range listeners {
+		go func(lis net.Listener) {
+			errs <- s.Serve(lis)
+		}(lis)
+	}
+	if httpServer != nil {
+		count++
+		go func() {
+			err := httpServer.Serve(httpListener)
+			if err == http.ErrServerClosed {
+				err = nil
+			}
+			errs <- err
+		}()
+	}
+
+	// When one of them stops, stop all the others
+	err = <-errs
+	s.Stop()
+	if httpServer != nil {
+		_ = httpServer.Close()
+	}
+	for i := 1; i < count; i++ {
+		<-errs
+	}
+
+	return err
 }
 
 type ServerConfig struct {
@@ -52,9 +212,48 @@ type ServerConfig struct {
 	// Port to listen on.
 	Port int
 
+	// Listen is the list of endpoints to listen on, in the format "unix:/path/to/socket", "tcp:ip:port"
+	// or "ip:port". Default is to listen only on IP:Port.
+	Listen []string
+
+	// Auth is how clients are authenticated. Default is to use TLS if EnableSnapshotsForUser created the
+	// certificates, and no authentication otherwise.
+	Auth AuthMode
+
+	// CredentialsDir is where the certificates and tokens are stored. Default is DefaultCredentialsDir().
+	CredentialsDir string
+
+	// PolicyFile is the JSON file with the Policy that says what each user can do. If empty, authenticated
+	// users can do anything.
+	PolicyFile string
+
+	// HTTPAddress is the address to listen for HTTP/JSON requests, in the format ip:port. Empty to disable.
+	// It uses HTTPS if Auth is AuthTLS. The API is described in openapi.yaml.
+	HTTPAddress string
+
+	// AuditLog is the file where each request received is appended, as one JSON per line. Empty to disable.
+	AuditLog string
+
+	// AuditSyslog also sends the audit log to syslog. Not available in Windows.
+	AuditSyslog bool
+
+	// Version of the application, returned to the clients. Default is "unknown".
+	Version string
+
+	// HealthCheckInterval is how often to check if the server can still create snapshots, to report it in
+	// the grpc.health.v1 service and in CanCreateSnapshots. Default is DefaultHealthCheckInterval.
+	HealthCheckInterval time.Duration
+
+	// Reflection enables the gRPC reflection service, to allow debugging with generic gRPC tools.
+	Reflection bool
+
 	// InactivityTime to stop the server, if this is > 0.
 	InactivityTime time.Duration
 
+	// LeaseTime is how long a backuper is kept alive without hearing from its client.
+	// After that it is closed and its snapshots are deleted. Default is DefaultLeaseTime.
+	LeaseTime time.Duration
+
 	InfoCallback InfoMessageCallback
 }
 
@@ -68,6 +267,21 @@ func (cfg *ServerConfig) setDefaults() {
 	if cfg.Port == 0 {
 		cfg.Port = DefaultPort
 	}
+	if cfg.Version == "" {
+		cfg.Version = "unknown"
+	}
+	if len(cfg.Listen) == 0 {
+		cfg.Listen = []string{cfg.Address()}
+	}
+	if cfg.CredentialsDir == "" {
+		cfg.CredentialsDir = DefaultCredentialsDir()
+	}
+	if cfg.HealthCheckInterval <= 0 {
+		cfg.HealthCheckInterval = DefaultHealthCheckInterval
+	}
+	if cfg.LeaseTime <= 0 {
+		cfg.LeaseTime = DefaultLeaseTime
+	}
 	if cfg.InfoCallback == nil {
 		cfg.InfoCallback = func(level MessageLevel, format string, a ...interface{}) {}
 	}
@@ -81,15 +295,50 @@ type server struct {
 	rpc.UnimplementedFsSnapshotServer
 
 	snapshoter   Snapshoter
+	version      string
+	health       *healthChecker
+	policy       *Policy
+	owners       *snapshotOwners
+	audit        *auditLog
+	mutex        sync.Mutex
 	backupers    map[uint32]*backuper
 	nextId       uint32
+	leaseTime    time.Duration
 	activityChan chan activity
 	infoCallback InfoMessageCallback
 }
 
 type backuper struct {
-	backuper        Backuper
+	backuper Backuper
+	owner    string
+
+	// The monitor can send messages at any time, so messageReceiver is protected by messageMutex
+	messageMutex    sync.Mutex
 	messageReceiver InfoMessageCallback
+
+	// Protected by server.mutex
+	leaseExempt     bool // Legacy clients don't renew the lease, so they keep the backuper until CloseBackup
+	leaseExpiration time.Time
+	activeCalls     int
+	snapshots       []*Snapshot // To know if a dir will re-use one of them
+}
+
+func (b *backuper) setMessageReceiver(receiver InfoMessageCallback) {
+	b.messageMutex.Lock()
+	defer b.messageMutex.Unlock()
+
+	b.messageReceiver = receiver
+}
+
+// sendMessage sends the message to the client of the current call, if there is one.
+func (b *backuper) sendMessage(level MessageLevel, format string, a ...interface{}) {
+	b.messageMutex.Lock()
+	receiver := b.messageReceiver
+	b.messageMutex.Unlock()
+
+	if receiver != nil {
+		receiver(level, format, a...)
+	}
 }
 
 func (s *server) sendActivity(a activity) {
@@ -98,15 +347,66 @@ func (s *server) sendActivity(a activity) {
 	}
 }
 
+// authorize returns the username of the caller and the policy rule that applies to it.
+func (s *server) authorize(ctx context.Context) (string, *PolicyRule, error) {
+	username := ""
+	if c := callerFromContext(ctx); c != nil {
+		username = c.Username
+	}
+
+	rule := s.policy.RuleFor(username)
+	if rule == nil {
+		return "", nil, status.Errorf(codes.PermissionDenied, "user '%v' is not allowed to use the server", username)
+	}
+
+	return username, rule, nil
+}
+
+func (s *server) GetServerInfo(ctx context.Context, request *rpc.GetServerInfoRequest) (*rpc.GetServerInfoReply, error) {
+	s.sendActivity(commandStart)
+	defer s.sendActivity(commandEnd)
+
+	s.infoCallback(TraceLevel, "GRPC Received request: GetServerInfo()")
+
+	_, _, err := s.authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	providers, err := s.snapshoter.ListProviders("")
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &rpc.GetServerInfoReply{
+		Version:       s.version,
+		ApiVersion:    APIVersion,
+		Os:            runtime.GOOS,
+		Arch:          runtime.GOARCH,
+		Providers:     make([]*rpc.Provider, len(providers)),
+		CaseSensitive: defaultCaseSensitive(), // Deprecated, only for older clients
+		Features:      serverFeatures,
+	}
+	for i, p := range providers {
+		reply.Providers[i] = convertProviderToRPC(p)
+	}
+
+	return reply, nil
+}
+
 func (s *server) CanCreateSnapshots(ctx context.Context, request *rpc.CanCreateSnapshotsRequest) (*rpc.CanCreateSnapshotsReply, error) {
 	s.sendActivity(commandStart)
 	defer s.sendActivity(commandEnd)
 
 	s.infoCallback(TraceLevel, "GRPC Received request: CanCreateSnapshots()")
 
-	// If the server has started it can create snapshots, at least for now
+	_, _, err := s.authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &rpc.CanCreateSnapshotsReply{
-		Can: true,
+		Can: s.health.CanCreateSnapshots(),
 	}, nil
 }
 
@@ -116,6 +416,11 @@ func (s *server) ListProviders(ctx context.Context, request *rpc.ListProvidersRe
 
 	s.infoCallback(TraceLevel, "GRPC Received request: ListProviders(\"%v\")", request.FilterId)
 
+	_, _, err := s.authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	providers, err := s.snapshoter.ListProviders(request.FilterId)
 	if err != nil {
 		return nil, err
@@ -137,11 +442,20 @@ func (s *server) ListSets(ctx context.Context, request *rpc.ListSetsRequest) (*r
 
 	s.infoCallback(TraceLevel, "GRPC Received request: ListSets(\"%v\")", request.FilterId)
 
+	username, rule, err := s.authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	sets, err := s.snapshoter.ListSets(request.FilterId)
 	if err != nil {
 		return nil, err
 	}
 
+	if !rule.ListOthersSnapshots {
+		sets = s.filterOwnedSets(sets, username)
+	}
+
 	reply := rpc.ListSetsReply{
 		Sets: make([]*rpc.SnapshotSet, len(sets)),
 	}
@@ -158,11 +472,20 @@ func (s *server) ListSnapshots(ctx context.Context, request *rpc.ListSnapshotsRe
 
 	s.infoCallback(TraceLevel, "GRPC Received request: ListSnapshots(\"%v\")", request.FilterId)
 
+	username, rule, err := s.authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	snaps, err := s.snapshoter.ListSnapshots(request.FilterId)
 	if err != nil {
 		return nil, err
 	}
 
+	if !rule.ListOthersSnapshots {
+		snaps = s.filterOwnedSnapshots(snaps, username)
+	}
+
 	reply := rpc.ListSnapshotsReply{
 		Snapshots: make([]*rpc.Snapshot, len(snaps)),
 	}
@@ -179,6 +502,11 @@ func (s *server) SimplifyId(ctx context.Context, request *rpc.SimplifyIdRequest)
 
 	s.infoCallback(TraceLevel, "GRPC Received request: SimplifyID(\"%v\")", request.Id)
 
+	_, _, err := s.authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	simpleId := s.snapshoter.SimplifyID(request.Id)
 
 	return &rpc.SimplifyIdReply{
@@ -192,11 +520,41 @@ func (s *server) DeleteSet(ctx context.Context, request *rpc.DeleteRequest) (*rp
 
 	s.infoCallback(TraceLevel, "GRPC Received request: DeleteSet(\"%v\", %v)", request.Id, request.Force)
 
+	username, rule, err := s.authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !rule.DeleteOthersSnapshots {
+		sets, err := s.snapshoter.ListSets(request.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, set := range sets {
+			for _, snap := range set.Snapshots {
+				if !s.owners.IsOwner(snap.ID, username) {
+					return nil, status.Errorf(codes.PermissionDenied, "user '%v' is not allowed to delete snapshot %v",
+						username, snap.ID)
+				}
+			}
+		}
+	}
+
+	var snapshotIDs []string
+	if isAuditing(ctx) {
+		snapshotIDs = s.listSnapshotIDsInSets(request.Id)
+	}
+
 	deleted, err := s.snapshoter.DeleteSet(request.Id, request.Force)
 	if err != nil {
 		return nil, err
 	}
 
+	if deleted {
+		auditDeletedSnapshots(ctx, snapshotIDs...)
+	}
+
 	return &rpc.DeleteReply{
 		Deleted: deleted,
 	}, nil
@@ -208,11 +566,34 @@ func (s *server) DeleteSnapshot(ctx context.Context, request *rpc.DeleteRequest)
 
 	s.infoCallback(TraceLevel, "GRPC Received request: DeleteSnapshot(\"%v\", %v)", request.Id, request.Force)
 
+	username, rule, err := s.authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !rule.DeleteOthersSnapshots {
+		snaps, err := s.snapshoter.ListSnapshots(request.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, snap := range snaps {
+			if !s.owners.IsOwner(snap.ID, username) {
+				return nil, status.Errorf(codes.PermissionDenied, "user '%v' is not allowed to delete snapshot %v",
+					username, snap.ID)
+			}
+		}
+	}
+
 	deleted, err := s.snapshoter.DeleteSnapshot(request.Id, request.Force)
 	if err != nil {
 		return nil, err
 	}
 
+	if deleted {
+		auditDeletedSnapshots(ctx, request.Id)
+	}
+
 	return &rpc.DeleteReply{
 		Deleted: deleted,
 	}, nil
@@ -224,6 +605,11 @@ func (s *server) ListMountPoints(ctx context.Context, request *rpc.ListMountPoin
 
 	s.infoCallback(TraceLevel, "GRPC Received request: ListMountPoints(\"%v\")", request.Volume)
 
+	_, _, err := s.authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	mps, err := s.snapshoter.ListMountPoints(request.Volume)
 	if err != nil {
 		return nil, err
@@ -234,6 +620,31 @@ func (s *server) ListMountPoints(ctx context.Context, request *rpc.ListMountPoin
 	}, nil
 }
 
+func (s *server) GetVolumeUsage(ctx context.Context, request *rpc.GetVolumeUsageRequest) (*rpc.GetVolumeUsageReply, error) {
+	s.sendActivity(commandStart)
+	defer s.sendActivity(commandEnd)
+
+	s.infoCallback(TraceLevel, "GRPC Received request: GetVolumeUsage(\"%v\")", request.Volume)
+
+	_, _, err := s.authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	usage, err := s.snapshoter.GetVolumeUsage(request.Volume)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.GetVolumeUsageReply{
+		Volume:           usage.Volume,
+		TotalSize:        usage.TotalSize,
+		FreeSpace:        usage.FreeSpace,
+		SnapshotsSize:    usage.SnapshotsSize,
+		SnapshotsMaxSize: usage.SnapshotsMaxSize,
+	}, nil
+}
+
 func (s *server) StartBackup(request *rpc.StartBackupRequest, response rpc.FsSnapshot_StartBackupServer) error {
 	s.sendActivity(commandStart)
 	defer s.sendActivity(commandEnd)
@@ -241,9 +652,22 @@ func (s *server) StartBackup(request *rpc.StartBackupRequest, response rpc.FsSna
 	s.infoCallback(TraceLevel, "GRPC Received request: StartBackup(\"%v\", %v, %v)",
 		request.ProviderId, request.TimeoutInSec, request.Simple)
 
-	b := &backuper{}
+	username, rule, err := s.authorize(response.Context())
+	if err != nil {
+		return err
+	}
+
+	err = s.checkConcurrentBackupers(username, rule)
+	if err != nil {
+		return err
+	}
+
+	b := &backuper{
+		owner:       username,
+		leaseExempt: isLegacyStream(response),
+	}
 
-	b.messageReceiver = func(level MessageLevel, format string, a ...interface{}) {
+	b.setMessageReceiver(func(level MessageLevel, format string, a ...interface{}) {
 		_ = response.Send(&rpc.StartBackupReply{
 			MessageOrResult: &rpc.StartBackupReply_Message{
 				Message: &rpc.OutputMessage{
@@ -252,20 +676,27 @@ func (s *server) StartBackup(request *rpc.StartBackupRequest, response rpc.FsSna
 				},
 			},
 		})
-	}
+	})
 
-	var err error
 	b.backuper, err = s.snapshoter.StartBackup(&BackupConfig{
 		ProviderID: request.ProviderId,
 		Timeout:    time.Duration(request.TimeoutInSec) * time.Second,
 		Simple:     request.Simple,
+		FreeSpace:  convertFreeSpaceConfigToLocal(request.FreeSpace),
+		Monitor:    convertMonitorConfigToLocal(request.Monitor),
+		Retry:      convertRetryConfigToLocal(request.Retry),
+
+		FailurePolicy: FailurePolicy(request.FailurePolicy),
 		InfoCallback: func(level MessageLevel, format string, a ...interface{}) {
 			s.infoCallback(level, format, a...)
-			b.messageReceiver(level, format, a...)
+			b.sendMessage(level, format, a...)
+		},
+		canDeleteSnapshot: func(snapshot *Snapshot) bool {
+			return rule.DeleteOthersSnapshots || s.owners.IsOwner(snapshot.ID, username)
 		},
 	})
 
-	b.messageReceiver = nil
+	b.setMessageReceiver(nil)
 
 	if err != nil {
 		return err
@@ -273,19 +704,34 @@ func (s *server) StartBackup(request *rpc.StartBackupRequest, response rpc.FsSna
 
 	id := atomic.AddUint32(&s.nextId, 1)
 
+	s.mutex.Lock()
+	// Check again because other backupers could have been started in the meantime
+	if rule.MaxConcurrentBackupers > 0 && s.countBackupers(username) >= rule.MaxConcurrentBackupers {
+		s.mutex.Unlock()
+		b.backuper.Close()
+		return tooManyBackupersError(username, rule)
+	}
+	b.leaseExpiration = time.Now().Add(s.leaseTime)
 	s.backupers[id] = b
+	s.mutex.Unlock()
 
 	err = response.Send(&rpc.StartBackupReply{
 		MessageOrResult: &rpc.StartBackupReply_Result{
 			Result: &rpc.StartBackupResult{
-				BackuperId: id,
+				BackuperId:     id,
+				CaseSensitive:  defaultCaseSensitive(), // Deprecated, only for older clients
+				LeaseTimeInSec: int32(math.Ceil(s.leaseTime.Seconds())),
+				LeaseTimeInMs:  s.leaseTime.Milliseconds(),
 			},
 		},
 	})
 
 	if err != nil {
-		b.backuper.Close()
+		s.mutex.Lock()
 		delete(s.backupers, id)
+		s.mutex.Unlock()
+
+		b.backuper.Close()
 		return err
 	}
 
@@ -301,12 +747,33 @@ func (s *server) TryToCreateTemporarySnapshot(request *rpc.TryToCreateTemporaryS
 	s.infoCallback(TraceLevel, "GRPC Received request: TryToCreateTemporarySnapshot(%v, \"%v\")",
 		request.BackuperId, request.Dir)
 
-	b, ok := s.backupers[request.BackuperId]
-	if !ok {
-		return errors.Errorf("unknown backuper: %v", request.BackuperId)
+	username, rule, err := s.authorize(response.Context())
+	if err != nil {
+		return err
+	}
+
+	b, err := s.acquireBackuper(request.BackuperId, username)
+	if err != nil {
+		return err
+	}
+	defer s.releaseBackuper(b)
+
+	// The backuper resolves symbolic links, so the policy must check the real path
+	dir, err := realPath(request.Dir)
+	if err != nil {
+		return err
+	}
+
+	if !rule.allowsDir(dir, b.paths()) {
+		return status.Errorf(codes.PermissionDenied, "user '%v' is not allowed to snapshot %v", username, dir)
+	}
+
+	err = s.checkSnapshotCount(b, dir, rule)
+	if err != nil {
+		return err
 	}
 
-	b.messageReceiver = func(level MessageLevel, format string, a ...interface{}) {
+	b.setMessageReceiver(func(level MessageLevel, format string, a ...interface{}) {
 		_ = response.Send(&rpc.TryToCreateTemporarySnapshotReply{
 			MessageOrResult: &rpc.TryToCreateTemporarySnapshotReply_Message{
 				Message: &rpc.OutputMessage{
@@ -315,22 +782,46 @@ func (s *server) TryToCreateTemporarySnapshot(request *rpc.TryToCreateTemporaryS
 				},
 			},
 		})
-	}
+	})
 
 	snapshotDir, snapshot, err := b.backuper.TryToCreateTemporarySnapshot(request.Dir)
 
-	b.messageReceiver = nil
+	b.setMessageReceiver(nil)
 
 	if err != nil {
 		return err
 	}
 
+	if snapshot != nil {
+		s.mutex.Lock()
+		created := b.findSnapshot(snapshot.ID) == nil
+		if created {
+			b.snapshots = append(b.snapshots, snapshot)
+		}
+		s.mutex.Unlock()
+
+		if created {
+			auditCreatedSnapshots(response.Context(), snapshot.ID)
+		}
+
+		err = s.owners.Add(snapshot.ID, username)
+		if err != nil {
+			s.infoCallback(InfoLevel, "Error recording owner of snapshot %v: %v", snapshot.ID, err)
+		}
+	}
+
+	result := &rpc.TryToCreateTemporarySnapshotResult{
+		SnapshotDir: snapshotDir,
+	}
+
+	// Providers can leave dirs without a snapshot, and then the client uses the original files
+	if snapshot != nil {
+		result.Snapshot = convertSnapshotToRPC(snapshot, true)
+	}
+
 	return response.Send(&rpc.TryToCreateTemporarySnapshotReply{
 		MessageOrResult: &rpc.TryToCreateTemporarySnapshotReply_Result{
-			Result: &rpc.TryToCreateTemporarySnapshotResult{
-				SnapshotDir: snapshotDir,
-				Snapshot:    convertSnapshotToRPC(snapshot, true),
-			},
+			Result: result,
 		},
 	})
 }
@@ -341,54 +832,733 @@ func (s *server) CloseBackup(request *rpc.CloseBackupRequest, response rpc.FsSna
 
 	s.infoCallback(TraceLevel, "GRPC Received request: CloseBackup(%v)", request.BackuperId)
 
-	b, ok := s.backupers[request.BackuperId]
-	if !ok {
-		return errors.Errorf("unknown backuper: %v", request.BackuperId)
+	username, _, err := s.authorize(response.Context())
+	if err != nil {
+		return err
+	}
+
+	b, err := s.removeBackuper(request.BackuperId, username)
+	if err != nil {
+		return err
 	}
 
-	b.messageReceiver = func(level MessageLevel, format string, a ...interface{}) {
+	b.setMessageReceiver(func(level MessageLevel, format string, a ...interface{}) {
 		_ = response.Send(&rpc.CloseBackupReply{
 			Message: &rpc.OutputMessage{
 				Level:   rpc.MessageLevel(level),
 				Message: fmt.Sprintf(format, a...),
 			},
 		})
-	}
+	})
 
 	b.backuper.Close()
 
-	b.messageReceiver = nil
+	b.setMessageReceiver(nil)
 
-	delete(s.backupers, request.BackuperId)
+	auditDeletedSnapshots(response.Context(), b.snapshotIDs()...)
 
 	s.sendActivity(backupEnd)
 
 	return nil
 }
 
-func convertProviderToRPC(p *Provider) *rpc.Provider {
-	return &rpc.Provider{
-		Id:      p.ID,
-		Name:    p.Name,
-		Version: p.Version,
-		Type:    p.Type,
-	}
-}
+func (s *server) RenewBackupLease(ctx context.Context, request *rpc.RenewBackupLeaseRequest) (*rpc.RenewBackupLeaseReply, error) {
+	s.infoCallback(TraceLevel, "GRPC Received request: RenewBackupLease(%v)", request.BackuperId)
 
-func convertProviderToLocal(p *rpc.Provider) *Provider {
-	return &Provider{
-		ID:      p.Id,
-		Name:    p.Name,
-		Version: p.Version,
-		Type:    p.Type,
+	username, _, err := s.authorize(ctx)
+	if err != nil {
+		return nil, err
 	}
-}
 
-func convertSnapshotSetToRPC(set *SnapshotSet, includeSnapshots bool) *rpc.SnapshotSet {
-	result := &rpc.SnapshotSet{
-		Id:                      set.ID,
-		CreationTime:            timeToInt64(set.CreationTime),
-		SnapshotCountOnCreation: int32(set.SnapshotCountOnCreation),
+	b, err := s.acquireBackuper(request.BackuperId, username)
+	if err != nil {
+		return nil, err
+	}
+
+	s.releaseBackuper(b)
+
+	return &rpc.RenewBackupLeaseReply{}, nil
+}
+
+func (s *server) ListFailedSnapshots(ctx context.Context, request *rpc.ListFailedSnapshotsRequest) (*rpc.ListFailedSnapshotsReply, error) {
+	s.infoCallback(TraceLevel, "GRPC Received request: ListFailedSnapshots(%v)", request.BackuperId)
+
+	username, _, err := s.authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := s.acquireBackuper(request.BackuperId, username)
+	if err != nil {
+		return nil, err
+	}
+	defer s.releaseBackuper(b)
+
+	result := &rpc.ListFailedSnapshotsReply{}
+
+	for _, f := range b.backuper.FailedSnapshots() {
+		result.Failures = append(result.Failures, &rpc.SnapshotFailure{
+			Snapshot: convertSnapshotToRPC(f.Snapshot, true),
+			Reason:   f.Reason,
+			Time:     timeToInt64(f.Time),
+		})
+	}
+
+	return result, nil
+}
+
+func (s *server) GetBackupReport(ctx context.Context, request *rpc.GetBackupReportRequest) (*rpc.GetBackupReportReply, error) {
+	s.infoCallback(TraceLevel, "GRPC Received request: GetBackupReport(%v)", request.BackuperId)
+
+	username, _, err := s.authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := s.acquireBackuper(request.BackuperId, username)
+	if err != nil {
+		return nil, err
+	}
+	defer s.releaseBackuper(b)
+
+	result := &rpc.GetBackupReportReply{}
+
+	for _, d := range b.backuper.Report().Directories {
+		result.Directories = append(result.Directories, convertDirectoryReportToRPC(d))
+	}
+
+	return result, nil
+}
+
+// acquireBackuper returns the backuper and renews its lease. While acquired the backuper can't expire.
+// releaseBackuper must be called after using it.
+func (s *server) acquireBackuper(id uint32, owner string) (*backuper, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	// Users can only use their own backupers
+	b, ok := s.backupers[id]
+	if !ok || b.owner != owner {
+		return nil, status.Errorf(codes.NotFound, "unknown backuper: %v", id)
+	}
+
+	b.activeCalls++
+	b.leaseExpiration = time.Now().Add(s.leaseTime)
+
+	return b, nil
+}
+
+func (s *server) releaseBackuper(b *backuper) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	b.activeCalls--
+	b.leaseExpiration = time.Now().Add(s.leaseTime)
+}
+
+func (s *server) removeBackuper(id uint32, owner string) (*backuper, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	b, ok := s.backupers[id]
+	if !ok || b.owner != owner {
+		return nil, status.Errorf(codes.NotFound, "unknown backuper: %v", id)
+	}
+
+	delete(s.backupers, id)
+
+	return b, nil
+}
+
+// countBackupers must be called with s.mutex locked.
+func (s *server) countBackupers(owner string) int {
+	result := 0
+	for _, b := range s.backupers {
+		if b.owner == owner {
+			result++
+		}
+	}
+
+	return result
+}
+
+func (s *server) checkConcurrentBackupers(username string, rule *PolicyRule) error {
+	if rule.MaxConcurrentBackupers <= 0 {
+		return nil
+	}
+
+	s.mutex.Lock()
+	count := s.countBackupers(username)
+	s.mutex.Unlock()
+
+	if count >= rule.MaxConcurrentBackupers {
+		return tooManyBackupersError(username, rule)
+	}
+
+	return nil
+}
+
+func tooManyBackupersError(username string, rule *PolicyRule) error {
+	return status.Errorf(codes.ResourceExhausted, "user '%v' already has %v backups running", username, rule.MaxConcurrentBackupers)
+}
+
+// checkSnapshotCount checks if a new snapshot can be created for the dir. Clients ask for mount points, so if
+// the backuper already has a snapshot of the same dir it will be re-used and does not count.
+func (s *server) checkSnapshotCount(b *backuper, dir string, rule *PolicyRule) error {
+	if rule.MaxSnapshots <= 0 {
+		return nil
+	}
+
+	// Dirs inside a snapshot already created re-use it
+	_, reused := b.backuper.ToSnapshotPath(dir)
+	if reused {
+		return nil
+	}
+
+	existing, err := s.snapshoter.ListSnapshots("")
+	if err != nil {
+		return err
+	}
+
+	count := s.owners.Count(existing, b.owner)
+	if count >= rule.MaxSnapshots {
+		return status.Errorf(codes.ResourceExhausted, "user '%v' already has %v snapshots", b.owner, count)
+	}
+
+	return nil
+}
+
+func (s *server) filterOwnedSnapshots(snaps []*Snapshot, username string) []*Snapshot {
+	result := make([]*Snapshot, 0, len(snaps))
+	for _, snap := range snaps {
+		if s.owners.IsOwner(snap.ID, username) {
+			result = append(result, snap)
+		}
+	}
+
+	return result
+}
+
+// filterOwnedSets returns only the sets that have some snapshot owned by the user, and only with those snapshots.
+func (s *server) filterOwnedSets(sets []*SnapshotSet, username string) []*SnapshotSet {
+	result := make([]*SnapshotSet, 0, len(sets))
+	for _, set := range sets {
+		snaps := s.filterOwnedSnapshots(set.Snapshots, username)
+		if len(snaps) == 0 {
+			continue
+		}
+
+		filtered := *set
+		filtered.Snapshots = snaps
+		result = append(result, &filtered)
+	}
+
+	return result
+}
+
+// snapshotIDs returns the IDs of the snapshots created by this backuper. It must be called after the
+// backuper was removed from the server.
+func (b *backuper) snapshotIDs() []string {
+	result := make([]string, 0, len(b.snapshots))
+	for _, snap := range b.snapshots {
+		result = append(result, snap.ID)
+	}
+
+	return result
+}
+
+func (s *server) listSnapshotIDsInSets(filterID string) []string {
+	sets, err := s.snapshoter.ListSets(filterID)
+	if err != nil {
+		return nil
+	}
+
+	var result []string
+	for _, set := range sets {
+		for _, snap := range set.Snapshots {
+			result = append(result, snap.ID)
+		}
+	}
+
+	return result
+}
+
+// paths returns how the backuper compares paths, to check them against the policy.
+func (b *backuper) paths() pathComparer {
+	result, _ := b.backuper.(pathComparer)
+	return result
+}
+
+// findSnapshot must be called with server.mutex locked.
+func (b *backuper) findSnapshot(id string) *Snapshot {
+	for _, snap := range b.snapshots {
+		if snap.ID == id {
+			return snap
+		}
+	}
+
+	return nil
+}
+
+// handleLeases closes the backupers whose client stopped renewing the lease, probably because it died.
+// Returns a function to stop checking.
+func (s *server) handleLeases() func() {
+	stop := make(chan struct{})
+
+	interval := s.leaseTime / 4
+	if interval < time.Second {
+		interval = time.Second
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				s.closeExpiredBackupers()
+			}
+		}
+	}()
+
+	return func() {
+		close(stop)
+	}
+}
+
+func (s *server) closeExpiredBackupers() {
+	now := time.Now()
+
+	var expired []uint32
+	var bs []*backuper
+
+	s.mutex.Lock()
+	for id, b := range s.backupers {
+		if !b.leaseExempt && b.activeCalls == 0 && now.After(b.leaseExpiration) {
+			expired = append(expired, id)
+			bs = append(bs, b)
+			delete(s.backupers, id)
+		}
+	}
+	s.mutex.Unlock()
+
+	for i, b := range bs {
+		s.infoCallback(InfoLevel, "Lease of backuper %v expired, closing it and deleting its snapshots", expired[i])
+
+		// No client to send the messages to
+		b.setMessageReceiver(nil)
+
+		start := time.Now()
+
+		b.backuper.Close()
+
+		s.audit.Write(&auditEntry{
+			Time:             start,
+			User:             b.owner,
+			Operation:        "LeaseExpired",
+			Args:             []byte(fmt.Sprintf(`{"backuperId":%v}`, expired[i])),
+			Result:           codes.OK.String(),
+			DeletedSnapshots: b.snapshotIDs(),
+			DurationMs:       time.Since(start).Milliseconds(),
+		})
+
+		s.sendActivity(backupEnd)
+	}
+}
+
+func (s *server) CleanupTemporarySnapshots(ctx context.Context, request *rpc.CleanupTemporarySnapshotsRequest) (*rpc.CleanupTemporarySnapshotsReply, error) {
+	s.sendActivity(commandStart)
+	defer s.sendActivity(commandEnd)
+
+	s.infoCallback(TraceLevel, "GRPC Received request: CleanupTemporarySnapshots()")
+
+	username, rule, err := s.authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The cleanup deletes the snapshots left by any process, not only by this user
+	if !rule.DeleteOthersSnapshots {
+		return nil, status.Errorf(codes.PermissionDenied, "user '%v' is not allowed to clean up temporary snapshots", username)
+	}
+
+	cleaned, err := s.snapshoter.CleanupTemporarySnapshots()
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.CleanupTemporarySnapshotsReply{
+		Cleaned: int32(cleaned),
+	}, nil
+}
+
+func (s *server) StatSnapshotFile(ctx context.Context, request *rpc.StatSnapshotFileRequest) (*rpc.StatSnapshotFileReply, error) {
+	s.sendActivity(commandStart)
+	defer s.sendActivity(commandEnd)
+
+	s.infoCallback(TraceLevel, "GRPC Received request: StatSnapshotFile(%v, \"%v\", \"%v\")",
+		request.BackuperId, request.SnapshotId, request.Path)
+
+	username, rule, err := s.authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := s.acquireBackuper(request.BackuperId, username)
+	if err != nil {
+		return nil, err
+	}
+	defer s.releaseBackuper(b)
+
+	f, err := s.resolveSnapshotFile(b, username, rule, request.SnapshotId, request.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.StatSnapshotFileReply{
+		Info: convertFileInfoToRPC(f.path, f.info, path.Base(request.Path)),
+	}, nil
+}
+
+func (s *server) ReadSnapshotDir(request *rpc.ReadSnapshotDirRequest, response rpc.FsSnapshot_ReadSnapshotDirServer) error {
+	s.sendActivity(commandStart)
+	defer s.sendActivity(commandEnd)
+
+	s.infoCallback(TraceLevel, "GRPC Received request: ReadSnapshotDir(%v, \"%v\", \"%v\")",
+		request.BackuperId, request.SnapshotId, request.Path)
+
+	username, rule, err := s.authorize(response.Context())
+	if err != nil {
+		return err
+	}
+
+	b, err := s.acquireBackuper(request.BackuperId, username)
+	if err != nil {
+		return err
+	}
+	defer s.releaseBackuper(b)
+
+	f, err := s.resolveSnapshotFile(b, username, rule, request.SnapshotId, request.Path)
+	if err != nil {
+		return err
+	}
+
+	if !f.info.IsDir() {
+		return status.Errorf(codes.InvalidArgument, "not a folder: %v", request.Path)
+	}
+
+	if !f.userReadable {
+		return status.Errorf(codes.PermissionDenied, "user '%v' is not allowed to read %v", username, f.originalPath)
+	}
+
+	entries, err := os.ReadDir(f.path)
+	if err != nil {
+		return fileErrorToRPC(request.Path, err)
+	}
+
+	reply := &rpc.ReadSnapshotDirReply{}
+
+	for _, e := range entries {
+		// Parents of the allowed dirs only show the entries needed to get to them
+		if !f.readable && !rule.allowsTraversal(filepath.Join(f.originalPath, e.Name()), b.paths()) {
+			continue
+		}
+
+		info, err := e.Info()
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return fileErrorToRPC(path.Join(request.Path, e.Name()), err)
+		}
+
+		reply.Entries = append(reply.Entries, convertFileInfoToRPC(filepath.Join(f.path, e.Name()), info, e.Name()))
+
+		if len(reply.Entries) == snapshotDirEntriesPerReply {
+			err = response.Send(reply)
+			if err != nil {
+				return err
+			}
+
+			reply = &rpc.ReadSnapshotDirReply{}
+		}
+	}
+
+	if len(reply.Entries) > 0 {
+		return response.Send(reply)
+	}
+
+	return nil
+}
+
+func (s *server) ReadSnapshotFile(request *rpc.ReadSnapshotFileRequest, response rpc.FsSnapshot_ReadSnapshotFileServer) error {
+	s.sendActivity(commandStart)
+	defer s.sendActivity(commandEnd)
+
+	s.infoCallback(TraceLevel, "GRPC Received request: ReadSnapshotFile(%v, \"%v\", \"%v\", %v, %v)",
+		request.BackuperId, request.SnapshotId, request.Path, request.Offset, request.Length)
+
+	username, rule, err := s.authorize(response.Context())
+	if err != nil {
+		return err
+	}
+
+	b, err := s.acquireBackuper(request.BackuperId, username)
+	if err != nil {
+		return err
+	}
+	defer s.releaseBackuper(b)
+
+	f, err := s.resolveSnapshotFile(b, username, rule, request.SnapshotId, request.Path)
+	if err != nil {
+		return err
+	}
+
+	if !f.readable || !f.userReadable {
+		return status.Errorf(codes.PermissionDenied, "user '%v' is not allowed to read %v", username, f.originalPath)
+	}
+
+	if !f.info.Mode().IsRegular() {
+		return status.Errorf(codes.InvalidArgument, "not a regular file: %v", request.Path)
+	}
+
+	if request.Offset < 0 || request.Length < 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid range: %v %v", request.Offset, request.Length)
+	}
+
+	file, err := os.Open(f.path)
+	if err != nil {
+		return fileErrorToRPC(request.Path, err)
+	}
+	defer file.Close()
+
+	var reader io.Reader = io.NewSectionReader(file, request.Offset, f.info.Size()-request.Offset)
+	if request.Length > 0 {
+		reader = io.LimitReader(reader, request.Length)
+	}
+
+	buffer := make([]byte, snapshotFileChunkSize)
+
+	for {
+		n, err := io.ReadFull(reader, buffer)
+		if n > 0 {
+			err := response.Send(&rpc.ReadSnapshotFileReply{
+				Data: buffer[:n],
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil
+		}
+		if err != nil {
+			return fileErrorToRPC(request.Path, err)
+		}
+	}
+}
+
+const (
+	snapshotDirEntriesPerReply = 1000
+	snapshotFileChunkSize      = 64 * 1024
+)
+
+type snapshotFile struct {
+	path         string
+	originalPath string
+	info         os.FileInfo
+	readable     bool
+	traversable  bool
+	userReadable bool
+}
+
+// resolveSnapshotFile finds a file inside one of the snapshots created by the backuper. The name is in io/fs
+// format, relative to the snapshot root. Snapshots are read only, so it is enough to check the path before
+// using it. Symbolic links are not followed, because they could point outside the snapshot.
+// The server can read any file, so only authenticated users can read them, and only if the policy allows it
+// and the user can access the original path.
+func (s *server) resolveSnapshotFile(b *backuper, username string, rule *PolicyRule, snapshotID string, name string) (*snapshotFile, error) {
+	if username == "" {
+		return nil, status.Errorf(codes.PermissionDenied, "anonymous users are not allowed to read snapshot files")
+	}
+	if s.policy == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "reading snapshot files needs a server policy")
+	}
+
+	if !fs.ValidPath(name) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid path: %v", name)
+	}
+
+	s.mutex.Lock()
+	snapshot := b.findSnapshot(snapshotID)
+	s.mutex.Unlock()
+
+	if snapshot == nil {
+		return nil, status.Errorf(codes.NotFound, "unknown snapshot: %v", snapshotID)
+	}
+
+	result := &snapshotFile{
+		path:         snapshot.SnapshotDir,
+		originalPath: filepath.Join(snapshot.OriginalDir, filepath.FromSlash(name)),
+	}
+
+	// Check before touching the files, to not even tell if they exist
+	result.readable = rule.allowsRead(result.originalPath, b.paths())
+	result.traversable = rule.allowsTraversal(result.originalPath, b.paths())
+	if !result.readable && !result.traversable {
+		return nil, status.Errorf(codes.PermissionDenied, "user '%v' is not allowed to read %v", username, result.originalPath)
+	}
+
+	var err error
+	result.userReadable, err = checkUserAccess(username, result.originalPath)
+	if err != nil {
+		s.infoCallback(DetailsLevel, "Denied access of user '%v' to %v: %v", username, result.originalPath, err)
+		return nil, status.Errorf(codes.PermissionDenied, "user '%v' is not allowed to read %v", username, result.originalPath)
+	}
+
+	info, err := os.Stat(result.path)
+	if err != nil {
+		return nil, fileErrorToRPC(name, err)
+	}
+
+	if name != "." {
+		parts := strings.Split(name, "/")
+
+		for i, part := range parts {
+			if !info.IsDir() {
+				return nil, status.Errorf(codes.NotFound, "%v does not exist", name)
+			}
+
+			result.path = addPathSeparatorAsSuffix(result.path) + part
+
+			info, err = os.Lstat(result.path)
+			if err != nil {
+				return nil, fileErrorToRPC(name, err)
+			}
+
+			if info.Mode()&fs.ModeSymlink != 0 && i < len(parts)-1 {
+				return nil, status.Errorf(codes.InvalidArgument, "symbolic links are not followed: %v",
+					path.Join(parts[:i+1]...))
+			}
+		}
+	}
+
+	result.info = info
+
+	return result, nil
+}
+
+// checkUserAccess returns an error if the user can't get to the path in the volume, and if the user can read it
+// (or list it, for dirs). Files removed after the snapshot was created can't be checked, so they are denied.
+func checkUserAccess(username string, path string) (bool, error) {
+	u, err := lookupFileUser(username)
+	if err != nil {
+		return false, err
+	}
+
+	var parents []string
+	for dir := filepath.Dir(path); ; dir = filepath.Dir(dir) {
+		parents = append(parents, dir)
+
+		if filepath.Dir(dir) == dir {
+			break
+		}
+	}
+
+	for i := len(parents) - 1; i >= 0; i-- {
+		info, err := os.Stat(parents[i])
+		if err != nil {
+			return false, err
+		}
+
+		if !u.canTraverse(parents[i], info) {
+			return false, errors.Errorf("permission denied: %v", parents[i])
+		}
+	}
+
+	info, err := os.Lstat(path)
+	if err != nil {
+		return false, err
+	}
+
+	return u.canRead(path, info), nil
+}
+
+func fileErrorToRPC(name string, err error) error {
+	switch {
+	case os.IsNotExist(err):
+		return status.Errorf(codes.NotFound, "%v does not exist", name)
+	case os.IsPermission(err):
+		return status.Errorf(codes.PermissionDenied, "permission denied: %v", name)
+	default:
+		return status.Errorf(codes.Unknown, "error reading %v: %v", name, err)
+	}
+}
+
+func convertFileInfoToRPC(fullPath string, info os.FileInfo, name string) *rpc.FileInfo {
+	result := &rpc.FileInfo{
+		Name:    name,
+		Size:    info.Size(),
+		Mode:    uint32(info.Mode()),
+		ModTime: info.ModTime().UnixNano(),
+	}
+
+	if info.Mode()&fs.ModeSymlink != 0 {
+		result.LinkTarget, _ = os.Readlink(fullPath)
+	}
+
+	result.Uid = -1
+	result.Gid = -1
+
+	// Only informative, so errors can be ignored
+	m, err := readLocalFileMetadata(fullPath, info)
+	if err == nil {
+		result.Uid = int64(m.UID)
+		result.Gid = int64(m.GID)
+		result.User = m.User
+		result.Group = m.Group
+		result.Device = m.Device
+		result.Inode = m.Inode
+		result.Links = m.Links
+
+		for name, value := range m.Xattrs {
+			result.Xattrs = append(result.Xattrs, &rpc.Xattr{
+				Name:  name,
+				Value: value,
+			})
+		}
+	}
+
+	return result
+}
+
+func convertProviderToRPC(p *Provider) *rpc.Provider {
+	return &rpc.Provider{
+		Id:      p.ID,
+		Name:    p.Name,
+		Version: p.Version,
+		Type:    p.Type,
+	}
+}
+
+func convertProviderToLocal(p *rpc.Provider) *Provider {
+	return &Provider{
+		ID:      p.Id,
+		Name:    p.Name,
+		Version: p.Version,
+		Type:    p.Type,
+	}
+}
+
+func convertSnapshotSetToRPC(set *SnapshotSet, includeSnapshots bool) *rpc.SnapshotSet {
+	result := &rpc.SnapshotSet{
+		Id:                      set.ID,
+		CreationTime:            timeToInt64(set.CreationTime),
+		SnapshotCountOnCreation: int32(set.SnapshotCountOnCreation),
 	}
 
 	if includeSnapshots {
@@ -402,6 +1572,10 @@ func convertSnapshotSetToRPC(set *SnapshotSet, includeSnapshots bool) *rpc.Snaps
 }
 
 func convertSnapshotSetToLocal(set *rpc.SnapshotSet, includeSnapshots bool) *SnapshotSet {
+	if set == nil {
+		return nil
+	}
+
 	result := &SnapshotSet{
 		ID:                      set.Id,
 		CreationTime:            int64ToTime(set.CreationTime),
@@ -429,7 +1603,16 @@ func convertSnapshotToRPC(snap *Snapshot, includeSet bool) *rpc.Snapshot {
 		Attributes:   snap.Attributes,
 	}
 
-	if includeSet {
+	if snap.Size != nil {
+		result.Size = &rpc.SnapshotSize{
+			Exclusive: snap.Size.Exclusive,
+			Shared:    snap.Size.Shared,
+			CowUsage:  snap.Size.CowUsage,
+		}
+	}
+
+	// File clones do not belong to a set
+	if includeSet && snap.Set != nil {
 		result.Set = convertSnapshotSetToRPC(snap.Set, false)
 	}
 
@@ -437,7 +1620,7 @@ func convertSnapshotToRPC(snap *Snapshot, includeSet bool) *rpc.Snapshot {
 }
 
 func convertSnapshotToLocal(snap *rpc.Snapshot, set *SnapshotSet) *Snapshot {
-	return &Snapshot{
+	result := &Snapshot{
 		ID:           snap.Id,
 		OriginalDir:  snap.OriginalDir,
 		SnapshotDir:  snap.SnapshotDir,
@@ -447,6 +1630,16 @@ func convertSnapshotToLocal(snap *rpc.Snapshot, set *SnapshotSet) *Snapshot {
 		State:        snap.State,
 		Attributes:   snap.Attributes,
 	}
+
+	if snap.Size != nil {
+		result.Size = &SnapshotSize{
+			Exclusive: snap.Size.Exclusive,
+			Shared:    snap.Size.Shared,
+			CowUsage:  snap.Size.CowUsage,
+		}
+	}
+
+	return result
 }
 
 func timeToInt64(t time.Time) int64 {
@@ -537,3 +1730,107 @@ func handleInactivity(s *grpc.Server, cfg *ServerConfig) chan activity {
 
 	return c
 }
+
+func convertFreeSpaceConfigToRPC(cfg *FreeSpaceConfig) *rpc.FreeSpaceConfig {
+	if cfg == nil {
+		return nil
+	}
+
+	return &rpc.FreeSpaceConfig{
+		MinFreeSpace:       cfg.MinFreeSpace,
+		MinFreePercent:     cfg.MinFreePercent,
+		MaxSnapshotsSize:   cfg.MaxSnapshotsSize,
+		Action:             rpc.FreeSpaceAction(cfg.Action),
+		ProtectedSnapshots: cfg.ProtectedSnapshots,
+	}
+}
+
+func convertFreeSpaceConfigToLocal(cfg *rpc.FreeSpaceConfig) *FreeSpaceConfig {
+	if cfg == nil {
+		return nil
+	}
+
+	return &FreeSpaceConfig{
+		MinFreeSpace:       cfg.MinFreeSpace,
+		MinFreePercent:     cfg.MinFreePercent,
+		MaxSnapshotsSize:   cfg.MaxSnapshotsSize,
+		Action:             FreeSpaceAction(cfg.Action),
+		ProtectedSnapshots: cfg.ProtectedSnapshots,
+	}
+}
+
+func convertMonitorConfigToRPC(cfg *MonitorConfig) *rpc.MonitorConfig {
+	if cfg == nil {
+		return nil
+	}
+
+	return &rpc.MonitorConfig{
+		IntervalInSec:   int32(cfg.Interval.Seconds()),
+		WarnThresholds:  cfg.WarnThresholds,
+		AutoExtend:      cfg.AutoExtend,
+		ExtendThreshold: cfg.ExtendThreshold,
+		ExtendSize:      cfg.ExtendSize,
+	}
+}
+
+func convertMonitorConfigToLocal(cfg *rpc.MonitorConfig) *MonitorConfig {
+	if cfg == nil {
+		return nil
+	}
+
+	return &MonitorConfig{
+		Interval:        time.Duration(cfg.IntervalInSec) * time.Second,
+		WarnThresholds:  cfg.WarnThresholds,
+		AutoExtend:      cfg.AutoExtend,
+		ExtendThreshold: cfg.ExtendThreshold,
+		ExtendSize:      cfg.ExtendSize,
+	}
+}
+
+func convertRetryConfigToRPC(cfg *RetryConfig) *rpc.RetryConfig {
+	if cfg == nil {
+		return nil
+	}
+
+	return &rpc.RetryConfig{
+		Attempts:       int32(cfg.Attempts),
+		BackoffInMs:    cfg.Backoff.Milliseconds(),
+		MaxBackoffInMs: cfg.MaxBackoff.Milliseconds(),
+		RetryAllErrors: cfg.RetryAllErrors,
+	}
+}
+
+func convertRetryConfigToLocal(cfg *rpc.RetryConfig) *RetryConfig {
+	if cfg == nil {
+		return nil
+	}
+
+	return &RetryConfig{
+		Attempts:       int(cfg.Attempts),
+		Backoff:        time.Duration(cfg.BackoffInMs) * time.Millisecond,
+		MaxBackoff:     time.Duration(cfg.MaxBackoffInMs) * time.Millisecond,
+		RetryAllErrors: cfg.RetryAllErrors,
+	}
+}
+
+func convertDirectoryReportToRPC(d *DirectoryReport) *rpc.DirectoryReport {
+	result := &rpc.DirectoryReport{
+		Directory:      d.Directory,
+		MountPoint:     d.MountPoint,
+		Status:         rpc.DirectoryStatus(d.Status),
+		Path:           d.Path,
+		ProviderId:     d.ProviderID,
+		FallbackReason: d.FallbackReason,
+		StartTime:      timeToInt64(d.StartTime),
+		DurationInMs:   d.Duration.Milliseconds(),
+	}
+
+	if d.Snapshot != nil {
+		result.Snapshot = convertSnapshotToRPC(d.Snapshot, true)
+	}
+	if d.Err != nil {
+		result.Error = d.Err.Error()
+	}
+
+	return result
+}
@@ -0,0 +1,220 @@
+package fs_snapshot
+
+import (
+	"context"
+	"os"
+	"os/user"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	rpc "github.com/pescuma/go-fs-snapshot/lib/fs_snapshot/api/v1"
+)
+
+// testServerSnapshoter only implements StartBackup.
+type testServerSnapshoter struct {
+	Snapshoter
+}
+
+func (s *testServerSnapshoter) StartBackup(cfg *BackupConfig) (Backuper, error) {
+	return &testServerBackuper{}, nil
+}
+
+type testServerBackuper struct {
+	baseBackuper
+	closed bool
+}
+
+func (b *testServerBackuper) Close() {
+	b.closed = true
+}
+
+// testTransportStream makes grpc.Method return the method of the request.
+type testTransportStream struct {
+	method string
+}
+
+func (s *testTransportStream) Method() string {
+	return s.method
+}
+
+func (s *testTransportStream) SetHeader(md metadata.MD) error {
+	return nil
+}
+
+func (s *testTransportStream) SendHeader(md metadata.MD) error {
+	return nil
+}
+
+func (s *testTransportStream) SetTrailer(md metadata.MD) error {
+	return nil
+}
+
+type testStartBackupStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	replies []*rpc.StartBackupReply
+}
+
+func (s *testStartBackupStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testStartBackupStream) Send(reply *rpc.StartBackupReply) error {
+	s.replies = append(s.replies, reply)
+	return nil
+}
+
+func newTestServer(snapshoter Snapshoter) *server {
+	return &server{
+		snapshoter:   snapshoter,
+		backupers:    map[uint32]*backuper{},
+		leaseTime:    time.Millisecond,
+		infoCallback: func(level MessageLevel, format string, a ...interface{}) {},
+	}
+}
+
+func TestServerLeaseOfLegacyClients(t *testing.T) {
+	tests := []struct {
+		name       string
+		service    string
+		wantClosed bool
+	}{
+		{"current service", rpc.FsSnapshot_ServiceDesc.ServiceName, true},
+		{"legacy service", legacyServiceName, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(&testServerSnapshoter{})
+
+			stream := &testStartBackupStream{
+				ctx: grpc.NewContextWithServerTransportStream(context.Background(),
+					&testTransportStream{method: "/" + tt.service + "/StartBackup"}),
+			}
+
+			err := s.StartBackup(&rpc.StartBackupRequest{}, stream)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(stream.replies) != 1 {
+				t.Fatalf("got %v replies, want 1", len(stream.replies))
+			}
+
+			id := stream.replies[0].GetResult().BackuperId
+			b := s.backupers[id].backuper.(*testServerBackuper)
+
+			time.Sleep(10 * time.Millisecond)
+			s.closeExpiredBackupers()
+
+			_, exists := s.backupers[id]
+			if b.closed != tt.wantClosed || exists == tt.wantClosed {
+				t.Errorf("closed = %v and exists = %v after the lease expired, want closed = %v",
+					b.closed, exists, tt.wantClosed)
+			}
+		})
+	}
+}
+
+func TestServerReadSnapshotFilePermissions(t *testing.T) {
+	current, err := user.Current()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	for _, name := range []string{"public.txt", "secret.txt"} {
+		err = os.WriteFile(filepath.Join(dir, name), []byte(name), 0o600)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Other users must be able to get to the files
+	for d := dir; hasPathPrefix(d, addPathSeparatorAsSuffix(os.TempDir())); d = filepath.Dir(d) {
+		_ = os.Chmod(d, 0o755)
+	}
+	err = os.Chmod(filepath.Join(dir, "public.txt"), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	allowAll := &Policy{Rules: []*PolicyRule{{Users: []string{"*"}}}}
+
+	tests := []struct {
+		name     string
+		username string
+		policy   *Policy
+		file     string
+		want     codes.Code
+	}{
+		{"allowed", current.Username, allowAll, "secret.txt", codes.OK},
+		{"anonymous", "", allowAll, "public.txt", codes.PermissionDenied},
+		{"no policy", current.Username, nil, "public.txt", codes.PermissionDenied},
+		{"dir not in policy", current.Username, &Policy{Rules: []*PolicyRule{
+			{Users: []string{"*"}, Dirs: []string{filepath.Join(dir, "other")}},
+		}}, "public.txt", codes.PermissionDenied},
+		{"other user can read", "nobody", allowAll, "public.txt", codes.OK},
+		{"other user can't read", "nobody", allowAll, "secret.txt", codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.username == "nobody" && (runtime.GOOS == "windows" || os.Geteuid() != 0) {
+				t.Skip("needs root to create files not readable by other users")
+			}
+
+			s := newTestServer(&testServerSnapshoter{})
+			s.policy = tt.policy
+			s.backupers[1] = &backuper{
+				backuper: &testServerBackuper{baseBackuper: baseBackuper{
+					volumes: newVolumeInfos(true),
+					listMountPoints: func(volume string) ([]string, error) {
+						return []string{volume + string(filepath.Separator)}, nil
+					},
+				}},
+				owner:    tt.username,
+				snapshots: []*Snapshot{
+					{ID: "snap", OriginalDir: dir, SnapshotDir: dir},
+				},
+			}
+
+			ctx := context.WithValue(context.Background(), callerKey{}, &caller{Username: tt.username})
+
+			stream := &testReadSnapshotFileStream{ctx: ctx}
+
+			err := s.ReadSnapshotFile(&rpc.ReadSnapshotFileRequest{
+				BackuperId: 1,
+				SnapshotId: "snap",
+				Path:       tt.file,
+			}, stream)
+
+			if status.Code(err) != tt.want {
+				t.Errorf("ReadSnapshotFile(%q) as '%v' = %v, want %v", tt.file, tt.username, err, tt.want)
+			}
+			if tt.want == codes.OK && string(stream.data) != tt.file {
+				t.Errorf("ReadSnapshotFile(%q) read %q", tt.file, stream.data)
+			}
+		})
+	}
+}
+
+type testReadSnapshotFileStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	data []byte
+}
+
+func (s *testReadSnapshotFileStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testReadSnapshotFileStream) Send(reply *rpc.ReadSnapshotFileReply) error {
+	s.data = append(s.data, reply.Data...)
+	return nil
+}
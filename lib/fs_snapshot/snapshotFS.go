@@ -0,0 +1,134 @@
+package fs_snapshot
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// FS is the io/fs view of a snapshot, with paths relative to the original directory. Stat does not follow
+// symbolic links, so they are reported as links, and ReadLink returns their destination.
+type FS interface {
+	fs.StatFS
+	fs.ReadDirFS
+
+	// ReadLink returns the destination of a symbolic link. It has the same signature as fs.ReadLinkFS in
+	// newer Go versions.
+	ReadLink(name string) (string, error)
+}
+
+// FS returns the contents of the snapshot, with the root at OriginalDir.
+// Snapshots created by a Backuper connected to a server are read through the server (if it supports it),
+// because they may not be readable by the current user.
+func (s *Snapshot) FS() FS {
+	return s.subFS(".")
+}
+
+// snapshotSubFS returns the contents of a directory of the original volume, read from the snapshot.
+func snapshotSubFS(snapshot *Snapshot, directory string) (FS, error) {
+	dir, err := absolutePath(directory)
+	if err != nil {
+		return nil, err
+	}
+
+	relative, err := filepath.Rel(snapshot.OriginalDir, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	relative = filepath.ToSlash(relative)
+	if !fs.ValidPath(relative) {
+		return nil, errors.Errorf("%v is not inside the snapshot of %v", directory, snapshot.OriginalDir)
+	}
+
+	return snapshot.subFS(relative), nil
+}
+
+// subFS returns the contents of a dir inside the snapshot. The dir is in io/fs format.
+func (s *Snapshot) subFS(dir string) FS {
+	if s.remote != nil {
+		return &clientSnapshotFS{
+			backuper: s.remote,
+			snapshot: s,
+			root:     dir,
+		}
+	}
+
+	return newLocalFS(filepath.Join(s.SnapshotDir, filepath.FromSlash(dir)))
+}
+
+// localFS reads the files directly. It is similar to os.DirFS, but works with the Windows device paths used
+// by the snapshots and implements FS in all Go versions.
+type localFS struct {
+	dir string
+}
+
+var _ FS = (*localFS)(nil)
+
+// DirFS returns the contents of a local dir (not of a snapshot), for example to verify a restored tree.
+func DirFS(dir string) FS {
+	return newLocalFS(dir)
+}
+
+func newLocalFS(dir string) *localFS {
+	return &localFS{
+		dir: dir,
+	}
+}
+
+func (f *localFS) toPath(op string, name string) (string, error) {
+	if !fs.ValidPath(name) || (runtime.GOOS == "windows" && strings.ContainsAny(name, `\:`)) {
+		return "", &fs.PathError{Op: op, Path: name, Err: fs.ErrInvalid}
+	}
+
+	if name == "." {
+		return f.dir, nil
+	}
+
+	return addPathSeparatorAsSuffix(f.dir) + filepath.FromSlash(name), nil
+}
+
+func (f *localFS) Open(name string) (fs.File, error) {
+	path, err := f.toPath("open", name)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return file, nil
+}
+
+func (f *localFS) Stat(name string) (fs.FileInfo, error) {
+	path, err := f.toPath("stat", name)
+	if err != nil {
+		return nil, err
+	}
+
+	return os.Lstat(path)
+}
+
+func (f *localFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	path, err := f.toPath("readdir", name)
+	if err != nil {
+		return nil, err
+	}
+
+	return os.ReadDir(path)
+}
+
+func (f *localFS) ReadLink(name string) (string, error) {
+	path, err := f.toPath("readlink", name)
+	if err != nil {
+		return "", err
+	}
+
+	return os.Readlink(path)
+}
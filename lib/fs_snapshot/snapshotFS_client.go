@@ -0,0 +1,420 @@
+package fs_snapshot
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"path"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	rpc "github.com/pescuma/go-fs-snapshot/lib/fs_snapshot/api/v1"
+)
+
+// clientSnapshotFS reads the files of a snapshot through the server, for when the snapshot is not readable by
+// the current user.
+type clientSnapshotFS struct {
+	backuper *clientBackuper
+	snapshot *Snapshot
+	root     string // Inside the snapshot, in io/fs format
+}
+
+var _ FS = (*clientSnapshotFS)(nil)
+
+// serverPath converts a name in this file system to the path inside the snapshot.
+func (f *clientSnapshotFS) serverPath(name string) string {
+	return path.Join(f.root, name)
+}
+
+func (f *clientSnapshotFS) Open(name string) (fs.File, error) {
+	info, err := f.stat("open", name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clientSnapshotFile{
+		fs:   f,
+		name: name,
+		info: info,
+	}, nil
+}
+
+func (f *clientSnapshotFS) Stat(name string) (fs.FileInfo, error) {
+	info, err := f.stat("stat", name)
+	if err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+func (f *clientSnapshotFS) stat(op string, name string) (*clientFileInfo, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: op, Path: name, Err: fs.ErrInvalid}
+	}
+
+	b := f.backuper
+
+	b.infoCallback(TraceLevel, "GRPC Sending server request: StatSnapshotFile(%v, \"%v\", \"%v\")",
+		b.backuperId, f.snapshot.ID, name)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	reply, err := b.client.StatSnapshotFile(ctx, &rpc.StatSnapshotFileRequest{
+		BackuperId: b.backuperId,
+		SnapshotId: f.snapshot.ID,
+		Path:       f.serverPath(name),
+	})
+	if err != nil {
+		b.infoCallback(TraceLevel, "GRPC error: %v", err.Error())
+		return nil, fileErrorToLocal(op, name, err)
+	}
+
+	return convertFileInfoToLocal(reply.Info), nil
+}
+
+// ReadDir returns the entries sorted by name, like os.ReadDir.
+func (f *clientSnapshotFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrInvalid}
+	}
+
+	b := f.backuper
+
+	b.infoCallback(TraceLevel, "GRPC Sending server request: ReadSnapshotDir(%v, \"%v\", \"%v\")",
+		b.backuperId, f.snapshot.ID, name)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	stream, err := b.client.ReadSnapshotDir(ctx, &rpc.ReadSnapshotDirRequest{
+		BackuperId: b.backuperId,
+		SnapshotId: f.snapshot.ID,
+		Path:       f.serverPath(name),
+	})
+	if err != nil {
+		b.infoCallback(TraceLevel, "GRPC error: %v", err.Error())
+		return nil, fileErrorToLocal("readdir", name, err)
+	}
+
+	var result []fs.DirEntry
+
+	for {
+		reply, err := stream.Recv()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			b.infoCallback(TraceLevel, "GRPC error: %v", err.Error())
+			return nil, fileErrorToLocal("readdir", name, err)
+		}
+
+		for _, e := range reply.Entries {
+			result = append(result, fs.FileInfoToDirEntry(convertFileInfoToLocal(e)))
+		}
+	}
+
+	return result, nil
+}
+
+func (f *clientSnapshotFS) ReadLink(name string) (string, error) {
+	info, err := f.stat("readlink", name)
+	if err != nil {
+		return "", err
+	}
+
+	if info.mode&fs.ModeSymlink == 0 {
+		return "", &fs.PathError{Op: "readlink", Path: name, Err: fs.ErrInvalid}
+	}
+
+	return info.linkTarget, nil
+}
+
+// clientSnapshotFile reads the file contents in a stream, that is only restarted when seeking.
+type clientSnapshotFile struct {
+	fs   *clientSnapshotFS
+	name string
+	info *clientFileInfo
+
+	offset int64
+	stream rpc.FsSnapshot_ReadSnapshotFileClient
+	cancel context.CancelFunc
+	buffer []byte
+
+	entries []fs.DirEntry
+	closed  bool
+}
+
+var _ io.Seeker = (*clientSnapshotFile)(nil)
+var _ io.ReaderAt = (*clientSnapshotFile)(nil)
+var _ fs.ReadDirFile = (*clientSnapshotFile)(nil)
+
+func (f *clientSnapshotFile) Stat() (fs.FileInfo, error) {
+	if f.closed {
+		return nil, &fs.PathError{Op: "stat", Path: f.name, Err: fs.ErrClosed}
+	}
+
+	return f.info, nil
+}
+
+func (f *clientSnapshotFile) Read(p []byte) (int, error) {
+	if f.closed {
+		return 0, &fs.PathError{Op: "read", Path: f.name, Err: fs.ErrClosed}
+	}
+	if f.info.IsDir() {
+		return 0, &fs.PathError{Op: "read", Path: f.name, Err: fs.ErrInvalid}
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	if f.stream == nil {
+		if f.offset >= f.info.size {
+			return 0, io.EOF
+		}
+
+		var ctx context.Context
+
+		// No timeout, because big files can take a long time to read
+		ctx, f.cancel = context.WithCancel(context.Background())
+
+		stream, err := f.fs.startReading(ctx, f.name, f.offset, 0)
+		if err != nil {
+			f.closeStream()
+			return 0, err
+		}
+
+		f.stream = stream
+	}
+
+	for len(f.buffer) == 0 {
+		reply, err := f.stream.Recv()
+
+		if err == io.EOF {
+			return 0, io.EOF
+		}
+
+		if err != nil {
+			f.fs.backuper.infoCallback(TraceLevel, "GRPC error: %v", err.Error())
+			return 0, fileErrorToLocal("read", f.name, err)
+		}
+
+		f.buffer = reply.Data
+	}
+
+	n := copy(p, f.buffer)
+	f.buffer = f.buffer[n:]
+	f.offset += int64(n)
+
+	return n, nil
+}
+
+func (f *clientSnapshotFile) ReadAt(p []byte, offset int64) (int, error) {
+	if f.closed {
+		return 0, &fs.PathError{Op: "read", Path: f.name, Err: fs.ErrClosed}
+	}
+	if f.info.IsDir() || offset < 0 {
+		return 0, &fs.PathError{Op: "read", Path: f.name, Err: fs.ErrInvalid}
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if offset >= f.info.size {
+		return 0, io.EOF
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := f.fs.startReading(ctx, f.name, offset, int64(len(p)))
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+	for n < len(p) {
+		reply, err := stream.Recv()
+
+		if err == io.EOF {
+			return n, io.EOF
+		}
+
+		if err != nil {
+			f.fs.backuper.infoCallback(TraceLevel, "GRPC error: %v", err.Error())
+			return n, fileErrorToLocal("read", f.name, err)
+		}
+
+		n += copy(p[n:], reply.Data)
+	}
+
+	return n, nil
+}
+
+func (f *clientSnapshotFile) Seek(offset int64, whence int) (int64, error) {
+	if f.closed {
+		return 0, &fs.PathError{Op: "seek", Path: f.name, Err: fs.ErrClosed}
+	}
+
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += f.offset
+	case io.SeekEnd:
+		offset += f.info.size
+	default:
+		return 0, &fs.PathError{Op: "seek", Path: f.name, Err: fs.ErrInvalid}
+	}
+
+	if offset < 0 {
+		return 0, &fs.PathError{Op: "seek", Path: f.name, Err: fs.ErrInvalid}
+	}
+
+	if offset != f.offset {
+		f.closeStream()
+		f.offset = offset
+	}
+
+	return offset, nil
+}
+
+func (f *clientSnapshotFile) ReadDir(n int) ([]fs.DirEntry, error) {
+	if f.closed {
+		return nil, &fs.PathError{Op: "readdir", Path: f.name, Err: fs.ErrClosed}
+	}
+	if !f.info.IsDir() {
+		return nil, &fs.PathError{Op: "readdir", Path: f.name, Err: fs.ErrInvalid}
+	}
+
+	if f.entries == nil {
+		entries, err := f.fs.ReadDir(f.name)
+		if err != nil {
+			return nil, err
+		}
+
+		f.entries = append([]fs.DirEntry{}, entries...)
+	}
+
+	if n <= 0 {
+		result := f.entries
+		f.entries = []fs.DirEntry{}
+		return result, nil
+	}
+
+	if len(f.entries) == 0 {
+		return nil, io.EOF
+	}
+
+	if n > len(f.entries) {
+		n = len(f.entries)
+	}
+
+	result := f.entries[:n]
+	f.entries = f.entries[n:]
+
+	return result, nil
+}
+
+func (f *clientSnapshotFile) Close() error {
+	if f.closed {
+		return &fs.PathError{Op: "close", Path: f.name, Err: fs.ErrClosed}
+	}
+
+	f.closeStream()
+	f.closed = true
+
+	return nil
+}
+
+func (f *clientSnapshotFile) closeStream() {
+	if f.cancel != nil {
+		f.cancel()
+	}
+
+	f.stream = nil
+	f.cancel = nil
+	f.buffer = nil
+}
+
+func (f *clientSnapshotFS) startReading(ctx context.Context, name string, offset int64, length int64) (rpc.FsSnapshot_ReadSnapshotFileClient, error) {
+	b := f.backuper
+
+	b.infoCallback(TraceLevel, "GRPC Sending server request: ReadSnapshotFile(%v, \"%v\", \"%v\", %v, %v)",
+		b.backuperId, f.snapshot.ID, name, offset, length)
+
+	stream, err := b.client.ReadSnapshotFile(ctx, &rpc.ReadSnapshotFileRequest{
+		BackuperId: b.backuperId,
+		SnapshotId: f.snapshot.ID,
+		Path:       f.serverPath(name),
+		Offset:     offset,
+		Length:     length,
+	})
+	if err != nil {
+		b.infoCallback(TraceLevel, "GRPC error: %v", err.Error())
+		return nil, fileErrorToLocal("read", name, err)
+	}
+
+	return stream, nil
+}
+
+type clientFileInfo struct {
+	name       string
+	size       int64
+	mode       fs.FileMode
+	modTime    time.Time
+	linkTarget string
+	metadata   *FileMetadata
+}
+
+func (i *clientFileInfo) Name() string       { return i.name }
+func (i *clientFileInfo) Size() int64        { return i.size }
+func (i *clientFileInfo) Mode() fs.FileMode  { return i.mode }
+func (i *clientFileInfo) ModTime() time.Time { return i.modTime }
+func (i *clientFileInfo) IsDir() bool        { return i.mode.IsDir() }
+func (i *clientFileInfo) Sys() interface{}   { return i.metadata }
+
+func convertFileInfoToLocal(info *rpc.FileInfo) *clientFileInfo {
+	result := &clientFileInfo{
+		name:       path.Base(info.Name),
+		size:       info.Size,
+		mode:       fs.FileMode(info.Mode),
+		modTime:    time.Unix(0, info.ModTime),
+		linkTarget: info.LinkTarget,
+		metadata: &FileMetadata{
+			UID:    int(info.Uid),
+			GID:    int(info.Gid),
+			User:   info.User,
+			Group:  info.Group,
+			Device: info.Device,
+			Inode:  info.Inode,
+			Links:  info.Links,
+		},
+	}
+
+	if len(info.Xattrs) > 0 {
+		result.metadata.Xattrs = make(map[string][]byte, len(info.Xattrs))
+		for _, x := range info.Xattrs {
+			result.metadata.Xattrs[x.Name] = x.Value
+		}
+	}
+
+	return result
+}
+
+// fileErrorToLocal converts the server errors to the errors io/fs users expect.
+func fileErrorToLocal(op string, name string, err error) error {
+	switch status.Code(err) {
+	case codes.NotFound:
+		err = fs.ErrNotExist
+	case codes.PermissionDenied:
+		err = fs.ErrPermission
+	case codes.InvalidArgument:
+		err = fs.ErrInvalid
+	}
+
+	return &fs.PathError{Op: op, Path: name, Err: err}
+}
@@ -0,0 +1,182 @@
+package fs_snapshot
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// MonitorConfig configures the checks done while a backuper is open, to find snapshots that are running out of
+// copy-on-write space or that became invalid.
+type MonitorConfig struct {
+	// Interval between checks. Default is 30 seconds.
+	Interval time.Duration
+
+	// WarnThresholds are the percentages of the copy-on-write space used that show a warning. Default is 80, 90
+	// and 95.
+	WarnThresholds []float64
+
+	// AutoExtend increases the copy-on-write space when the usage reaches ExtendThreshold, using free space from
+	// the volume (or LVM volume group) that has it. Supported by VSS and LVM.
+	AutoExtend bool
+
+	// ExtendThreshold is the percentage of the copy-on-write space used that makes it be extended. Default is 90.
+	ExtendThreshold float64
+
+	// ExtendSize is how much to increase the copy-on-write space each time, in bytes. Default is 1 GiB.
+	ExtendSize int64
+}
+
+func (cfg *MonitorConfig) setDefaults() {
+	if cfg.Interval <= 0 {
+		cfg.Interval = 30 * time.Second
+	}
+	if len(cfg.WarnThresholds) == 0 {
+		cfg.WarnThresholds = []float64{80, 90, 95}
+	}
+	if cfg.ExtendThreshold <= 0 {
+		cfg.ExtendThreshold = 90
+	}
+	if cfg.ExtendSize <= 0 {
+		cfg.ExtendSize = 1 << 30
+	}
+}
+
+// snapshotStateInvalid is the state of snapshots that can't be read anymore, for providers that keep them after
+// that (like LVM).
+const snapshotStateInvalid = "invalid"
+
+// SnapshotFailure is a snapshot that became invalid after being created. Files read from it may be incomplete
+// or wrong, so the backup is not reliable.
+type SnapshotFailure struct {
+	// Snapshot is nil if it is not known which snapshot failed (for example, if the server could not be asked)
+	Snapshot *Snapshot
+	Reason   string
+	Time     time.Time
+}
+
+// snapshotMonitor checks the snapshots of a backuper in a goroutine.
+type snapshotMonitor struct {
+	cfg          MonitorConfig
+	snapshoter   Snapshoter
+	backuper     *baseBackuper
+	infoCallback InfoMessageCallback
+
+	// extend increases the copy-on-write space of a snapshot. nil if the provider does not support it
+	extend func(snapshot *Snapshot, size int64) error
+
+	warned   map[string]float64
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// newSnapshotMonitor returns nil if the config has no Monitor.
+func newSnapshotMonitor(cfg *BackupConfig, snapshoter Snapshoter, backuper *baseBackuper,
+	extend func(snapshot *Snapshot, size int64) error,
+	infoCallback InfoMessageCallback,
+) *snapshotMonitor {
+
+	if cfg.Monitor == nil {
+		return nil
+	}
+
+	result := &snapshotMonitor{
+		cfg:          *cfg.Monitor,
+		snapshoter:   snapshoter,
+		backuper:     backuper,
+		infoCallback: infoCallback,
+		extend:       extend,
+		warned:       map[string]float64{},
+		stop:         make(chan struct{}),
+	}
+	result.cfg.setDefaults()
+
+	sort.Float64s(result.cfg.WarnThresholds)
+
+	if result.cfg.AutoExtend && extend == nil {
+		infoCallback(DetailsLevel, "The provider does not support extending the copy-on-write space")
+		result.cfg.AutoExtend = false
+	}
+
+	go result.run()
+
+	return result
+}
+
+func (m *snapshotMonitor) run() {
+	ticker := time.NewTicker(m.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.stop:
+			return
+
+		case <-ticker.C:
+			for _, snapshot := range m.backuper.listCreatedSnapshots() {
+				m.check(snapshot)
+			}
+		}
+	}
+}
+
+func (m *snapshotMonitor) check(snapshot *Snapshot) {
+	current, err := m.snapshoter.ListSnapshots(snapshot.ID)
+	if err != nil {
+		m.infoCallback(DetailsLevel, "Error checking snapshot %v: %v", snapshot.ID, err)
+		return
+	}
+
+	if len(current) == 0 {
+		m.backuper.markFailed(snapshot, "the snapshot was deleted, probably by the system because it ran out of space")
+		return
+	}
+
+	if current[0].State == snapshotStateInvalid {
+		m.backuper.markFailed(snapshot, "the snapshot became invalid because its copy-on-write space filled up")
+		return
+	}
+
+	size := current[0].Size
+	if size == nil || size.CowUsage < 0 {
+		return
+	}
+
+	threshold := -1.0
+	for _, t := range m.cfg.WarnThresholds {
+		if size.CowUsage >= t {
+			threshold = t
+		}
+	}
+
+	if last, ok := m.warned[snapshot.ID]; threshold >= 0 && (!ok || threshold > last) {
+		m.warned[snapshot.ID] = threshold
+		m.infoCallback(InfoLevel, "WARNING: the snapshot of %v is using %.1f%% of its copy-on-write space",
+			snapshot.OriginalDir, size.CowUsage)
+	}
+
+	if m.cfg.AutoExtend && size.CowUsage >= m.cfg.ExtendThreshold {
+		m.infoCallback(InfoLevel, "Extending the copy-on-write space of %v by %v",
+			snapshot.OriginalDir, FormatSize(m.cfg.ExtendSize))
+
+		err = m.extend(current[0], m.cfg.ExtendSize)
+		if err != nil {
+			m.infoCallback(InfoLevel, "Error extending the copy-on-write space of %v: %v", snapshot.OriginalDir, err)
+			return
+		}
+
+		// Warn again if it fills up
+		delete(m.warned, snapshot.ID)
+	}
+}
+
+// Stop can be called more than once, and with a nil monitor.
+func (m *snapshotMonitor) Stop() {
+	if m == nil {
+		return
+	}
+
+	m.stopOnce.Do(func() {
+		close(m.stop)
+	})
+}
@@ -35,9 +35,19 @@ type Snapshoter interface {
 	// ListMountPoints lists all mount points inside a volume.
 	ListMountPoints(volume string) ([]string, error)
 
+	// GetVolumeUsage returns the space of a volume, and how much of it is used by snapshots.
+	GetVolumeUsage(volume string) (*VolumeUsage, error)
+
 	// StartBackup creates a Backuper to allow easy backup creation.
+	// Temporary snapshots left behind by processes that died before closing their Backuper are
+	// cleaned up before starting.
 	StartBackup(cfg *BackupConfig) (Backuper, error)
 
+	// CleanupTemporarySnapshots deletes temporary snapshots and mounts left behind by processes that
+	// died before closing their Backuper.
+	// Returns the number of temporary snapshots and mounts deleted.
+	CleanupTemporarySnapshots() (int, error)
+
 	// Close frees all resources.
 	Close()
 }
@@ -57,7 +67,8 @@ type SnapshotSet struct {
 }
 
 type Snapshot struct {
-	ID           string
+	ID string
+	// OriginalDir and SnapshotDir are files for providers that snapshot single files (like reflink)
 	OriginalDir  string
 	SnapshotDir  string
 	CreationTime time.Time
@@ -65,6 +76,9 @@ type Snapshot struct {
 	Provider     *Provider
 	State        string
 	Attributes   string
+	Size         *SnapshotSize // nil if the provider doesn't know it
+
+	remote *clientBackuper // To read the files through the server
 }
 
 type ConnectionType int
@@ -95,12 +109,28 @@ type BackupConfig struct {
 	// In Windows this means do not use VSS Writers.
 	Simple bool
 
+	// FreeSpace is checked before creating each snapshot. nil disables the checks.
+	FreeSpace *FreeSpaceConfig
+
+	// Monitor checks the snapshots while the backuper is open. nil disables it.
+	Monitor *MonitorConfig
+
+	// Retry configures retrying the creation of snapshots. nil tries only once.
+	Retry *RetryConfig
+
+	// FailurePolicy is what TryToCreateTemporarySnapshot does when a snapshot can't be created.
+	FailurePolicy FailurePolicy
+
 	// If set, overrides the info callback from the snapshoter
 	InfoCallback InfoMessageCallback
+
+	// canDeleteSnapshot is used by the server to restrict the snapshots deleted by the free space checks
+	canDeleteSnapshot func(snapshot *Snapshot) bool
 }
 
 var ErrNotSupportedInThisOS = errors.New("snapshots not supported in this OS")
 var ErrSnapshotFailedInPreviousAttempt = errors.New("snapshot failed in a previous attempt")
+var ErrSnapshotInvalidated = errors.New("snapshot became invalid after being created")
 
 // NewSnapshoter creates a new snapshoter.
 // In case of error a null snapshoter is returned, so you can use it without problem.
@@ -145,7 +175,7 @@ func newClientSnapshoterStartingServer(cfg *SnapshoterConfig) (Snapshoter, error
 		return result, nil
 	}
 
-	if cfg.ServerIP != DefaultIP && cfg.ServerPort != DefaultPort {
+	if cfg.ServerSocket != "" || (cfg.ServerIP != DefaultIP && cfg.ServerPort != DefaultPort) {
 		// Not the default config, so don't try to start the server
 		return nil, err
 	}
@@ -163,7 +193,19 @@ type SnapshoterConfig struct {
 	ConnectionType ConnectionType
 	ServerIP       string
 	ServerPort     int
-	InfoCallback   InfoMessageCallback
+
+	// ServerSocket is the path of a unix socket to connect to. If set, ServerIP and ServerPort are ignored.
+	// If not set and the default IP and port are used, DefaultSocket is used if it exists.
+	ServerSocket string
+
+	// ServerAuth is how to authenticate with the server. Default is to use TLS if EnableSnapshotsForUser
+	// created the certificates for the current user, and no authentication otherwise.
+	ServerAuth AuthMode
+
+	// ServerCredentialsDir is where the certificates and tokens are stored. Default is DefaultCredentialsDir().
+	ServerCredentialsDir string
+
+	InfoCallback InfoMessageCallback
 }
 
 func (cfg *SnapshoterConfig) setDefaults() {
@@ -173,6 +215,13 @@ func (cfg *SnapshoterConfig) setDefaults() {
 	if cfg.ServerPort == 0 {
 		cfg.ServerPort = DefaultPort
 	}
+	if cfg.ServerSocket == "" && cfg.ServerIP == DefaultIP && cfg.ServerPort == DefaultPort &&
+		unixSocketsSupported() && fileExists(DefaultSocket) {
+		cfg.ServerSocket = DefaultSocket
+	}
+	if cfg.ServerCredentialsDir == "" {
+		cfg.ServerCredentialsDir = DefaultCredentialsDir()
+	}
 	if cfg.InfoCallback == nil {
 		cfg.InfoCallback = func(level MessageLevel, format string, a ...interface{}) {}
 	}
@@ -8,9 +8,10 @@ import (
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
-	"github.com/pescuma/go-fs-snapshot/lib/fs_snapshot/internal/rpc"
+	rpc "github.com/pescuma/go-fs-snapshot/lib/fs_snapshot/api/v1"
 )
 
 func newClientSnapshoter(cfg *SnapshoterConfig) (Snapshoter, error) {
@@ -21,31 +22,86 @@ func newClientSnapshoter(cfg *SnapshoterConfig) (Snapshoter, error) {
 	}
 
 	addr := fmt.Sprintf("%v:%v", cfg.ServerIP, cfg.ServerPort)
+	if cfg.ServerSocket != "" {
+		addr = "unix://" + cfg.ServerSocket
+	}
+
+	opts, err := clientDialOptions(cfg.ServerAuth, cfg.ServerCredentialsDir, cfg.ServerSocket)
+	if err != nil {
+		return nil, err
+	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
 
-	result.conn, err = grpc.DialContext(ctx, addr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithBlock(),
-	)
+	result.conn, err = grpc.DialContext(ctx, addr, append(opts, grpc.WithBlock())...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not connect to server: %v", addr)
 	}
 
-	cfg.InfoCallback(DetailsLevel, "Connected to server at: %v", addr)
-
 	result.client = rpc.NewFsSnapshotClient(result.conn)
 
+	err = result.loadServerInfo()
+	if err != nil {
+		_ = result.conn.Close()
+		return nil, err
+	}
+
+	cfg.InfoCallback(DetailsLevel, "Connected to server at: %v (version %v, API version %v)",
+		addr, result.info.Version, result.info.ApiVersion)
+
 	return result, nil
 }
 
 type clientSnapshoter struct {
 	conn         *grpc.ClientConn
 	client       rpc.FsSnapshotClient
+	info         *rpc.GetServerInfoReply
 	infoCallback InfoMessageCallback
 }
 
+// loadServerInfo finds out what the server supports. Servers from before GetServerInfo existed use the
+// legacy service name and don't have any of the optional features.
+func (s *clientSnapshoter) loadServerInfo() error {
+	s.infoCallback(TraceLevel, "GRPC Sending server request: GetServerInfo()")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	info, err := s.client.GetServerInfo(ctx, &rpc.GetServerInfoRequest{})
+	if status.Code(err) == codes.Unimplemented {
+		s.infoCallback(DetailsLevel, "Server does not support GetServerInfo, using compatibility mode")
+
+		s.client = rpc.NewFsSnapshotClient(&legacyClientConn{cc: s.conn})
+		s.info = &rpc.GetServerInfoReply{
+			Version: "unknown",
+		}
+		return nil
+	}
+	if err != nil {
+		s.infoCallback(TraceLevel, "GRPC error: %v", err.Error())
+		return errors.Wrap(err, "could not get server information")
+	}
+
+	if info.ApiVersion > APIVersion {
+		s.infoCallback(DetailsLevel, "Server uses a newer API version (%v). Some features may not be available.",
+			info.ApiVersion)
+	}
+
+	s.info = info
+	return nil
+}
+
+func (s *clientSnapshoter) hasFeature(feature string) bool {
+	for _, f := range s.info.Features {
+		if f == feature {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (s *clientSnapshoter) ListProviders(filterID string) ([]*Provider, error) {
 	s.infoCallback(TraceLevel, "GRPC Sending server request: ListProviders(\"%v\")", filterID)
 
@@ -108,6 +164,11 @@ func (s *clientSnapshoter) ListSnapshots(filterID string) ([]*Snapshot, error) {
 	setsById := make(map[string]*SnapshotSet)
 
 	for i, snap := range reply.Snapshots {
+		if snap.Set == nil {
+			result[i] = convertSnapshotToLocal(snap, nil)
+			continue
+		}
+
 		set, exists := setsById[snap.Set.Id]
 		if !exists {
 			set = convertSnapshotSetToLocal(snap.Set, false)
@@ -192,6 +253,33 @@ func (s *clientSnapshoter) ListMountPoints(volume string) ([]string, error) {
 	return reply.MountPoints, nil
 }
 
+func (s *clientSnapshoter) GetVolumeUsage(volume string) (*VolumeUsage, error) {
+	if !s.hasFeature(FeatureVolumeUsage) {
+		return nil, errors.New("the server does not support getting the volume usage, it needs to be updated")
+	}
+
+	s.infoCallback(TraceLevel, "GRPC Sending server request: GetVolumeUsage(\"%v\")", volume)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	reply, err := s.client.GetVolumeUsage(ctx, &rpc.GetVolumeUsageRequest{
+		Volume: volume,
+	})
+	if err != nil {
+		s.infoCallback(TraceLevel, "GRPC error: %v", err.Error())
+		return nil, err
+	}
+
+	return &VolumeUsage{
+		Volume:           reply.Volume,
+		TotalSize:        reply.TotalSize,
+		FreeSpace:        reply.FreeSpace,
+		SnapshotsSize:    reply.SnapshotsSize,
+		SnapshotsMaxSize: reply.SnapshotsMaxSize,
+	}, nil
+}
+
 func (s *clientSnapshoter) StartBackup(cfg *BackupConfig) (Backuper, error) {
 	if cfg == nil {
 		cfg = &BackupConfig{}
@@ -202,6 +290,16 @@ func (s *clientSnapshoter) StartBackup(cfg *BackupConfig) (Backuper, error) {
 		ic = s.infoCallback
 	}
 
+	if cfg.FreeSpace != nil && !s.hasFeature(FeatureFreeSpace) {
+		return nil, errors.New("the server does not support checking the free space, it needs to be updated")
+	}
+	if cfg.Monitor != nil && !s.hasFeature(FeatureMonitor) {
+		return nil, errors.New("the server does not support monitoring the snapshots, it needs to be updated")
+	}
+	if cfg.Retry != nil && !s.hasFeature(FeatureRetry) {
+		return nil, errors.New("the server does not support retrying the snapshots, it needs to be updated")
+	}
+
 	ic(TraceLevel, "GRPC Sending server request: StartBackup(\"%v\", %v, %v)",
 		cfg.ProviderID, int32(cfg.Timeout.Seconds()), cfg.Simple)
 
@@ -212,6 +310,11 @@ func (s *clientSnapshoter) StartBackup(cfg *BackupConfig) (Backuper, error) {
 		ProviderId:   cfg.ProviderID,
 		TimeoutInSec: int32(cfg.Timeout.Seconds()),
 		Simple:       cfg.Simple,
+		FreeSpace:    convertFreeSpaceConfigToRPC(cfg.FreeSpace),
+		Monitor:      convertMonitorConfigToRPC(cfg.Monitor),
+		Retry:        convertRetryConfigToRPC(cfg.Retry),
+		// The failure policy is applied by the client backuper, so the errors are not wrapped twice
+		FailurePolicy: rpc.FailurePolicy_Fallback,
 	})
 	if err != nil {
 		ic(TraceLevel, "GRPC error: %v", err.Error())
@@ -220,7 +323,7 @@ func (s *clientSnapshoter) StartBackup(cfg *BackupConfig) (Backuper, error) {
 
 	received := false
 	backuperId := uint32(0)
-	caseSensitive := true
+	leaseTime := time.Duration(0)
 
 	for {
 		reply, err := stream.Recv()
@@ -241,7 +344,10 @@ func (s *clientSnapshoter) StartBackup(cfg *BackupConfig) (Backuper, error) {
 		case *rpc.StartBackupReply_Result:
 			received = true
 			backuperId = mr.Result.BackuperId
-			caseSensitive = mr.Result.CaseSensitive
+			leaseTime = time.Duration(mr.Result.LeaseTimeInSec) * time.Second
+			if mr.Result.LeaseTimeInMs > 0 {
+				leaseTime = time.Duration(mr.Result.LeaseTimeInMs) * time.Millisecond
+			}
 		}
 	}
 
@@ -249,7 +355,42 @@ func (s *clientSnapshoter) StartBackup(cfg *BackupConfig) (Backuper, error) {
 		return nil, errors.New("GRPC error: missing reply data")
 	}
 
-	return newClientBackuper(s.client, backuperId, caseSensitive, cfg.Timeout, s.ListMountPoints, ic), nil
+	if !s.hasFeature(FeatureLeases) {
+		leaseTime = 0
+	}
+
+	result := newClientBackuper(s.client, backuperId, cfg.Timeout, leaseTime,
+		s.hasFeature(FeatureSnapshotFiles), cfg.Monitor != nil, s.ListMountPoints, ic)
+
+	result.failurePolicy = cfg.FailurePolicy
+	if s.hasFeature(FeatureFileSnapshots) {
+		result.baseBackuper.createFileSnapshot = result.createFileSnapshot
+	}
+	if cfg.Retry != nil {
+		// The server retries and knows which errors are transient, so always ask it again
+		result.retry = &RetryConfig{Attempts: 1, RetryAllErrors: true}
+	}
+
+	return result, nil
+}
+
+func (s *clientSnapshoter) CleanupTemporarySnapshots() (int, error) {
+	if !s.hasFeature(FeatureCleanup) {
+		return 0, errors.New("the server does not support cleaning up temporary snapshots, it needs to be updated")
+	}
+
+	s.infoCallback(TraceLevel, "GRPC Sending server request: CleanupTemporarySnapshots()")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	reply, err := s.client.CleanupTemporarySnapshots(ctx, &rpc.CleanupTemporarySnapshotsRequest{})
+	if err != nil {
+		s.infoCallback(TraceLevel, "GRPC error: %v", err.Error())
+		return 0, err
+	}
+
+	return int(reply.Cleaned), nil
 }
 
 func (s *clientSnapshoter) Close() {
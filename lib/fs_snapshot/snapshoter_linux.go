@@ -0,0 +1,502 @@
+//go:build linux
+
+package fs_snapshot
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+const reflinkProviderID = "reflink"
+
+func startServerForOS(infoCb InfoMessageCallback) error {
+	return ErrNotSupportedInThisOS
+}
+
+// newSnapshoterForOS uses LVM snapshots for dirs in logical volumes, if running as root, and reflinks, that clone
+// single files in file systems that support them (like Btrfs and XFS).
+// If not running as root and the server socket exists, the server is used instead, because it can create LVM
+// snapshots. Only reflinks are used if it can't be reached.
+func newSnapshoterForOS(cfg *SnapshoterConfig) (Snapshoter, error) {
+	if os.Geteuid() != 0 && cfg.ConnectionType == LocalOrServer && cfg.ServerSocket != "" {
+		result, err := newClientSnapshoter(cfg)
+		if err == nil {
+			return result, nil
+		}
+
+		cfg.InfoCallback(DetailsLevel, "Not running as root and could not connect to the server, so only "+
+			"reflinks will be used: %v", err)
+	}
+
+	return &linuxSnapshoter{
+		infoCallback: cfg.InfoCallback,
+	}, nil
+}
+
+type linuxSnapshoter struct {
+	infoCallback InfoMessageCallback
+}
+
+func (s *linuxSnapshoter) SimplifyID(id string) string {
+	return id
+}
+
+func (s *linuxSnapshoter) ListProviders(filterID string) ([]*Provider, error) {
+	providers := []*Provider{s.newProvider()}
+	if lvmAvailable() {
+		providers = append([]*Provider{s.newLvmProvider()}, providers...)
+	}
+
+	result := []*Provider{}
+	for _, p := range providers {
+		if filterID == "" || filterID == p.ID {
+			result = append(result, p)
+		}
+	}
+
+	return result, nil
+}
+
+func (s *linuxSnapshoter) ListSets(filterID string) ([]*SnapshotSet, error) {
+	return []*SnapshotSet{}, nil
+}
+
+// ListSnapshots returns the LVM snapshots, including the ones not created by fs_snapshot. The file clones are not
+// returned, because they only exist while the backuper that created them is open.
+func (s *linuxSnapshoter) ListSnapshots(filterID string) ([]*Snapshot, error) {
+	result := []*Snapshot{}
+
+	if !lvmAvailable() {
+		return result, nil
+	}
+
+	volumes, err := listLvmVolumes(s.infoCallback)
+	if err != nil {
+		return nil, err
+	}
+
+	mounts, err := readMountTable()
+	if err != nil {
+		return nil, err
+	}
+
+	provider := s.newLvmProvider()
+
+	for _, v := range volumes {
+		if !v.isSnapshot() {
+			continue
+		}
+		if filterID != "" && filterID != v.ID() {
+			continue
+		}
+
+		// Unmounted volumes are shown by their device
+		originalDir := "/dev/" + v.VG + "/" + v.Origin
+		if origin := findLvmVolume(volumes, v.VG, v.Origin); origin != nil {
+			if m := findMountOfLvmVolume(mounts, volumes, origin); m != nil {
+				originalDir = m.Dir
+			}
+		}
+
+		snapshotDir := ""
+		if m := findMountOfLvmVolume(mounts, volumes, v); m != nil {
+			snapshotDir = m.Dir
+		}
+
+		state := "created"
+		if v.isInvalid() {
+			state = snapshotStateInvalid
+		}
+
+		result = append(result, &Snapshot{
+			ID:           v.ID(),
+			OriginalDir:  originalDir,
+			SnapshotDir:  snapshotDir,
+			CreationTime: v.CreationTime,
+			Provider:     provider,
+			State:        state,
+			Attributes:   v.Attr,
+			Size:         newLvmSnapshotSize(v, volumes),
+		})
+	}
+
+	return result, nil
+}
+
+// newLvmSnapshotSize returns the size of a snapshot. Thin snapshots share the space of their pool, so they show
+// the usage of the whole pool.
+func newLvmSnapshotSize(v *lvmVolume, volumes []*lvmVolume) *SnapshotSize {
+	if !v.isThin() {
+		return &SnapshotSize{
+			Exclusive: v.usedSize(),
+			Shared:    -1,
+			CowUsage:  v.DataPercent,
+		}
+	}
+
+	cowUsage := -1.0
+	if pool := findLvmVolume(volumes, v.VG, v.Pool); pool != nil {
+		cowUsage = pool.DataPercent
+	}
+
+	return &SnapshotSize{
+		Exclusive: -1,
+		Shared:    -1,
+		CowUsage:  cowUsage,
+	}
+}
+
+func (s *linuxSnapshoter) DeleteSet(id string, force bool) (bool, error) {
+	return false, errors.New("snapshot sets not supported by reflinks")
+}
+
+// DeleteSnapshot deletes an LVM snapshot. If it is mounted, force unmounts it first.
+func (s *linuxSnapshoter) DeleteSnapshot(id string, force bool) (bool, error) {
+	if !lvmAvailable() {
+		return false, nil
+	}
+
+	volumes, err := listLvmVolumes(s.infoCallback)
+	if err != nil {
+		return false, err
+	}
+
+	var snapshot *lvmVolume
+	for _, v := range volumes {
+		if v.isSnapshot() && v.ID() == id {
+			snapshot = v
+		}
+	}
+	if snapshot == nil {
+		return false, nil
+	}
+
+	mounts, err := readMountTable()
+	if err != nil {
+		return false, err
+	}
+
+	if m := findMountOfLvmVolume(mounts, volumes, snapshot); m != nil {
+		if !force {
+			return false, errors.Errorf("snapshot %v is mounted at %v", id, m.Dir)
+		}
+
+		s.infoCallback(DetailsLevel, "Unmounting snapshot at %v", m.Dir)
+		err = run(s.infoCallback, "umount", m.Dir)
+		if err != nil {
+			return false, errors.Wrapf(err, "error unmounting %v", m.Dir)
+		}
+	}
+
+	err = removeLvmSnapshot(s.infoCallback, id)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (s *linuxSnapshoter) ListMountPoints(volume string) ([]string, error) {
+	if volume != "" {
+		return nil, errors.Errorf("unknown volume: %v", volume)
+	}
+
+	mounts, err := readMountTable()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []string
+	for _, m := range mounts {
+		result = append(result, m.Dir)
+	}
+
+	return result, nil
+}
+
+type mountTableEntry struct {
+	Device string
+	Dir    string
+	FSType string
+}
+
+// readMountTable returns the mounts in /proc/self/mounts, in mount order.
+func readMountTable() ([]*mountTableEntry, error) {
+	file, err := os.Open("/proc/self/mounts")
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing mount points")
+	}
+	defer file.Close()
+
+	var result []*mountTableEntry
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 3 {
+			continue
+		}
+
+		result = append(result, &mountTableEntry{
+			Device: unescapeMountPath(fields[0]),
+			Dir:    unescapeMountPath(fields[1]),
+			FSType: fields[2],
+		})
+	}
+
+	err = scanner.Err()
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing mount points")
+	}
+
+	return result, nil
+}
+
+// findMount returns the last mount of a dir, that hides the previous ones, or nil if it is not a mount point.
+func findMount(mounts []*mountTableEntry, dir string) *mountTableEntry {
+	dir = filepath.Clean(dir)
+
+	for i := len(mounts) - 1; i >= 0; i-- {
+		if mounts[i].Dir == dir {
+			return mounts[i]
+		}
+	}
+
+	return nil
+}
+
+// findMountOfLvmVolume returns the first mount of a logical volume, or nil if it is not mounted.
+func findMountOfLvmVolume(mounts []*mountTableEntry, volumes []*lvmVolume, v *lvmVolume) *mountTableEntry {
+	for _, m := range mounts {
+		if !strings.HasPrefix(m.Device, "/dev/") {
+			continue
+		}
+
+		if mv := findLvmVolumeOfDevice(volumes, m.Device); mv != nil && mv.ID() == v.ID() {
+			return m
+		}
+	}
+
+	return nil
+}
+
+// unescapeMountPath decodes the octal escapes (like \040 for space) used in /proc/self/mounts.
+func unescapeMountPath(path string) string {
+	var sb strings.Builder
+
+	for i := 0; i < len(path); i++ {
+		if path[i] == '\\' && i+3 < len(path) {
+			c, err := strconv.ParseUint(path[i+1:i+4], 8, 8)
+			if err == nil {
+				sb.WriteByte(byte(c))
+				i += 3
+				continue
+			}
+		}
+
+		sb.WriteByte(path[i])
+	}
+
+	return sb.String()
+}
+
+// GetVolumeUsage includes the size of the thick LVM snapshots of the volume. The clones share the data with the
+// original files, and thin snapshots the space of their pool, so they are not included.
+func (s *linuxSnapshoter) GetVolumeUsage(volume string) (*VolumeUsage, error) {
+	result, err := newVolumeUsage(volume)
+	if err != nil {
+		return nil, err
+	}
+
+	if !lvmAvailable() {
+		return result, nil
+	}
+
+	mounts, err := readMountTable()
+	if err != nil {
+		return nil, err
+	}
+
+	mount := findMount(mounts, volume)
+	if mount == nil {
+		return result, nil
+	}
+
+	volumes, err := listLvmVolumes(s.infoCallback)
+	if err != nil {
+		return nil, err
+	}
+
+	lv := findLvmVolumeOfDevice(volumes, mount.Device)
+	if lv == nil || lv.isThin() {
+		return result, nil
+	}
+
+	result.SnapshotsSize = 0
+	result.SnapshotsMaxSize = 0
+
+	for _, v := range volumes {
+		if v.VG != lv.VG || v.Origin != lv.Name {
+			continue
+		}
+
+		used := v.usedSize()
+		if used < 0 {
+			result.SnapshotsSize = -1
+			result.SnapshotsMaxSize = -1
+			break
+		}
+
+		result.SnapshotsSize += used
+		result.SnapshotsMaxSize += v.Size
+	}
+
+	return result, nil
+}
+
+func (s *linuxSnapshoter) StartBackup(cfg *BackupConfig) (Backuper, error) {
+	if cfg == nil {
+		cfg = &BackupConfig{}
+	}
+
+	var useLvm, useReflink bool
+	switch cfg.ProviderID {
+	case "":
+		useLvm = lvmAvailable()
+		useReflink = true
+	case lvmProviderID:
+		if !lvmAvailable() {
+			return nil, errors.New("LVM snapshots need root and the lvm2 tools")
+		}
+		useLvm = true
+	case reflinkProviderID:
+		useReflink = true
+	default:
+		return nil, errors.Errorf("unknown provider id: %v", cfg.ProviderID)
+	}
+
+	ic := cfg.InfoCallback
+	if ic == nil {
+		ic = s.infoCallback
+	}
+
+	cleanupTemporarySnapshots(s, ic)
+
+	result := newLinuxBackuper(s, useLvm, useReflink, ic)
+	result.setPolicies(cfg)
+
+	// Only the LVM snapshots are monitored, because the clones have no reserved space
+	if useLvm {
+		result.monitor = newSnapshotMonitor(cfg, s, &result.baseBackuper, result.extendCowSpace, ic)
+	}
+
+	return result, nil
+}
+
+func (s *linuxSnapshoter) CleanupTemporarySnapshots() (int, error) {
+	return cleanupOrphanedJournals(func(e *journalEntry) error {
+		return s.undoJournalEntry(e, s.infoCallback)
+	}, s.infoCallback)
+}
+
+func (s *linuxSnapshoter) undoJournalEntry(e *journalEntry, infoCb InfoMessageCallback) error {
+	if e.Data == "" {
+		infoCb(InfoLevel, "Ignoring %v created at %v because it was not finished", e.Type, e.CreationTime)
+		return nil
+	}
+
+	switch e.Type {
+	case journalLvmSnapshot:
+		return s.undoLvmSnapshot(e, infoCb)
+
+	case journalMount:
+		if !isTemporaryPath(e.Data, getMountsDir()) {
+			return errors.Errorf("refusing to unmount %v because it was not created by fs_snapshot", e.Data)
+		}
+
+		mounts, err := readMountTable()
+		if err != nil {
+			return err
+		}
+		if findMount(mounts, e.Data) == nil {
+			return nil
+		}
+
+		infoCb(DetailsLevel, "Unmounting snapshot at %v", e.Data)
+		return run(infoCb, "umount", e.Data)
+
+	case journalFile:
+		if !isCloneDir(filepath.Dir(e.Data)) {
+			return errors.Errorf("refusing to delete %v because it was not created by fs_snapshot", e.Data)
+		}
+
+		infoCb(DetailsLevel, "Deleting file clone %v", e.Data)
+		err := os.Remove(e.Data)
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+
+	case journalDir:
+		if isTemporaryPath(e.Data, getMountsDir()) {
+			infoCb(DetailsLevel, "Deleting snapshot mount folder %v", e.Data)
+		} else if isCloneDir(e.Data) {
+			infoCb(DetailsLevel, "Deleting file clone folder %v", e.Data)
+		} else {
+			return errors.Errorf("refusing to delete %v because it was not created by fs_snapshot", e.Data)
+		}
+
+		return syscall.Rmdir(e.Data)
+
+	default:
+		return errors.Errorf("unknown journal entry type: %v", e.Type)
+	}
+}
+
+// undoLvmSnapshot deletes a snapshot left by a backuper, if it still exists.
+func (s *linuxSnapshoter) undoLvmSnapshot(e *journalEntry, infoCb InfoMessageCallback) error {
+	vg, name, err := parseLvmSnapshotID(e.Data)
+	if err != nil {
+		return err
+	}
+
+	volumes, err := listLvmVolumes(infoCb)
+	if err != nil {
+		return err
+	}
+
+	v := findLvmVolume(volumes, vg, name)
+	if v == nil {
+		return nil
+	}
+	if !v.isSnapshot() {
+		return errors.Errorf("refusing to delete %v because it is not a snapshot", e.Data)
+	}
+
+	return removeLvmSnapshot(infoCb, e.Data)
+}
+
+func (s *linuxSnapshoter) Close() {
+}
+
+func (s *linuxSnapshoter) newLvmProvider() *Provider {
+	return &Provider{
+		ID:   lvmProviderID,
+		Name: "LVM snapshots",
+		Type: "console application",
+	}
+}
+
+func (s *linuxSnapshoter) newProvider() *Provider {
+	return &Provider{
+		ID:   reflinkProviderID,
+		Name: "Reflink file clones",
+		Type: "file clone",
+	}
+}
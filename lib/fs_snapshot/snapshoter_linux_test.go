@@ -0,0 +1,84 @@
+//go:build linux
+
+package fs_snapshot
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestUnescapeMountPath(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/mnt/data", "/mnt/data"},
+		{`/mnt/my\040disk`, "/mnt/my disk"},
+		{`/mnt/tab\011and\012newline`, "/mnt/tab\tand\nnewline"},
+		{`/mnt/back\134slash`, `/mnt/back\slash`},
+		{`/mnt/end\040`, "/mnt/end "},
+		{`/mnt/short\04`, `/mnt/short\04`},
+		{`/mnt/not\999octal`, `/mnt/not\999octal`},
+		{`\`, `\`},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := unescapeMountPath(tt.path); got != tt.want {
+				t.Errorf("unescapeMountPath(%q) = %q, want %q", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindMount(t *testing.T) {
+	mounts := []*mountTableEntry{
+		{Device: "/dev/sda1", Dir: "/", FSType: "ext4"},
+		{Device: "/dev/sda2", Dir: "/home", FSType: "xfs"},
+		{Device: "/dev/sdb1", Dir: "/home", FSType: "btrfs"},
+	}
+
+	tests := []struct {
+		dir  string
+		want *mountTableEntry
+	}{
+		{"/", mounts[0]},
+		{"/home/", mounts[2]},
+		{"/home/user", nil},
+		{"/mnt", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dir, func(t *testing.T) {
+			if got := findMount(mounts, tt.dir); got != tt.want {
+				t.Errorf("findMount(%q) = %v, want %v", tt.dir, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsCloneDir(t *testing.T) {
+	tests := []struct {
+		dir  string
+		want bool
+	}{
+		{filepath.Join(getClonesDir(), temporaryPrefix+"123"), true},
+		{filepath.Join(getClonesDir(), temporaryPrefix+"123") + "/", true},
+		{filepath.Join(getClonesDir(), "other"), false},
+		{filepath.Join(getClonesDir(), "sub", temporaryPrefix+"123"), false},
+		{"/mnt/data/.fs_snapshot/" + temporaryPrefix + "123", true},
+		{"/mnt/data/.fs_snapshot/other", false},
+		{"/mnt/data/" + temporaryPrefix + "123", false},
+		{"/mnt/data/." + temporaryPrefix + "123", false},
+		{"/mnt/data/.fs_snapshot", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dir, func(t *testing.T) {
+			if got := isCloneDir(tt.dir); got != tt.want {
+				t.Errorf("isCloneDir(%q) = %v, want %v", tt.dir, got, tt.want)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ package fs_snapshot
 import (
 	"regexp"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/pkg/errors"
@@ -16,6 +17,13 @@ const (
 	providerID = "tmutil-local"
 )
 
+// pendingLocalSnapshotWindow is how long after the journal entry the local snapshot of a dead process can
+// have been created.
+const pendingLocalSnapshotWindow = time.Minute
+
+var tmutilCreatedRE = regexp.MustCompile("Created local snapshot with date: ([0-9-]+)")
+var tmutilDateRE = regexp.MustCompile(`^[0-9]{4}-[0-9]{2}-[0-9]{2}-[0-9]{6}$`)
+
 func startServerForOS(infoCb InfoMessageCallback) error {
 	return errors.New("can't start server with elevated privileges - run with sudo if needed")
 }
@@ -134,6 +142,11 @@ func (s *macosSnapshoter) listMountPoints() (map[string]string, error) {
 	}, nil
 }
 
+// GetVolumeUsage does not include the snapshots size, because APFS does not report it.
+func (s *macosSnapshoter) GetVolumeUsage(volume string) (*VolumeUsage, error) {
+	return newVolumeUsage(volume)
+}
+
 func (s *macosSnapshoter) StartBackup(cfg *BackupConfig) (Backuper, error) {
 	if cfg == nil {
 		cfg = &BackupConfig{}
@@ -153,7 +166,110 @@ func (s *macosSnapshoter) StartBackup(cfg *BackupConfig) (Backuper, error) {
 		ic = s.infoCallback
 	}
 
-	return newMacosBackuper(s, mountPoints, ic), nil
+	cleanupTemporarySnapshots(s, ic)
+
+	result := newMacosBackuper(s, mountPoints, ic)
+	result.setPolicies(cfg)
+	result.freeSpace = newFreeSpaceGuard(cfg, s, ic)
+	// APFS snapshots have no reserved space, but they can be deleted by the system if the volume is full
+	result.monitor = newSnapshotMonitor(cfg, s, &result.baseBackuper, nil, ic)
+
+	return result, nil
+}
+
+func (s *macosSnapshoter) CleanupTemporarySnapshots() (int, error) {
+	return cleanupOrphanedJournals(func(e *journalEntry) error {
+		return s.undoJournalEntry(e, s.infoCallback)
+	}, s.infoCallback)
+}
+
+func (s *macosSnapshoter) undoJournalEntry(e *journalEntry, infoCb InfoMessageCallback) error {
+	if e.Type == journalTmutilSnapshot && e.Data == "" {
+		return s.deletePendingLocalSnapshot(e, infoCb)
+	}
+
+	if e.Data == "" {
+		infoCb(InfoLevel, "Ignoring %v created at %v because it was not finished", e.Type, e.CreationTime)
+		return nil
+	}
+
+	switch e.Type {
+	case journalMount:
+		if !isTemporaryPath(e.Data, getMountsDir()) {
+			return errors.Errorf("refusing to unmount %v because it was not created by fs_snapshot", e.Data)
+		}
+
+		infoCb(DetailsLevel, "Unmounting snapshot at %v", e.Data)
+		return run(infoCb, "umount", e.Data)
+
+	case journalDir:
+		if !isTemporaryPath(e.Data, getMountsDir()) {
+			return errors.Errorf("refusing to delete %v because it was not created by fs_snapshot", e.Data)
+		}
+
+		infoCb(DetailsLevel, "Deleting snapshot mount folder %v", e.Data)
+		return syscall.Rmdir(e.Data)
+
+	case journalTmutilSnapshot:
+		if !tmutilDateRE.MatchString(e.Data) {
+			return errors.Errorf("invalid local snapshot date: %v", e.Data)
+		}
+
+		snapshots, err := s.ListSnapshots(e.Data)
+		if err != nil {
+			return err
+		}
+		if len(snapshots) == 0 {
+			return nil
+		}
+
+		infoCb(DetailsLevel, "Deleting local snapshot with date %v", e.Data)
+		return run(infoCb, "tmutil", "deletelocalsnapshots", e.Data)
+
+	default:
+		return errors.Errorf("unknown journal entry type: %v", e.Type)
+	}
+}
+
+// deletePendingLocalSnapshot handles a process that died while tmutil was creating a local snapshot, so its
+// date was not written to the journal. It is found by the creation time, and only deleted if it is the only
+// one created in that window that is not in use (local snapshots created by Time Machine look the same).
+func (s *macosSnapshoter) deletePendingLocalSnapshot(e *journalEntry, infoCb InfoMessageCallback) error {
+	snapshots, err := s.ListSnapshots("")
+	if err != nil {
+		return err
+	}
+
+	active, err := listActiveJournalEntries()
+	if err != nil {
+		return err
+	}
+
+	start := e.CreationTime.Truncate(time.Second)
+	end := e.CreationTime.Add(pendingLocalSnapshotWindow)
+
+	var found []*Snapshot
+	for _, snapshot := range snapshots {
+		if snapshot.CreationTime.Before(start) || snapshot.CreationTime.After(end) || isTemporarySnapshot(snapshot, active) {
+			continue
+		}
+
+		found = append(found, snapshot)
+	}
+
+	switch len(found) {
+	case 0:
+		return nil
+
+	case 1:
+		date := s.SimplifyID(found[0].ID)
+		infoCb(DetailsLevel, "Deleting local snapshot with date %v", date)
+		return run(infoCb, "tmutil", "deletelocalsnapshots", date)
+
+	default:
+		return errors.Errorf("found %v local snapshots created after %v, unable to know which one was left behind",
+			len(found), start.Local().Format("2006-01-02 15:04:05"))
+	}
 }
 
 func (s *macosSnapshoter) Close() {
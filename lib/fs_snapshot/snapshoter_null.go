@@ -37,9 +37,17 @@ func (s *nullSnapshoter) ListMountPoints(volume string) ([]string, error) {
 	return nil, errors.New("not implemented")
 }
 
+func (s *nullSnapshoter) GetVolumeUsage(volume string) (*VolumeUsage, error) {
+	return newVolumeUsage(volume)
+}
+
 func (s *nullSnapshoter) StartBackup(opts *BackupConfig) (Backuper, error) {
 	return newNullBackuper(), nil
 }
 
+func (s *nullSnapshoter) CleanupTemporarySnapshots() (int, error) {
+	return 0, nil
+}
+
 func (s *nullSnapshoter) Close() {
 }
@@ -149,6 +149,8 @@ type snapshotsBuilder struct {
 	providersById map[string]*Provider
 	setsById      map[string]*SnapshotSet
 
+	cowUsageByVolume map[string]float64
+
 	Sets      []*SnapshotSet
 	Snapshots []*Snapshot
 }
@@ -173,6 +175,7 @@ func (s *windowsSnapshoter) newSnapshotsBuilder(filter func(setID, snapshotID st
 	}
 
 	b.setsById = map[string]*SnapshotSet{}
+	b.cowUsageByVolume = map[string]float64{}
 
 	return b, nil
 }
@@ -209,6 +212,7 @@ func (b *snapshotsBuilder) AddSnapshot(props *internal_windows.VssSnapshotProper
 				Set:          set,
 				State:        props.Status.Str(),
 				Attributes:   props.SnapshotAttributes.Str(),
+				Size:         b.getSnapshotSize(props),
 			}
 
 			set.Snapshots = append(set.Snapshots, snapshot)
@@ -225,6 +229,27 @@ func (b *snapshotsBuilder) AddSnapshot(props *internal_windows.VssSnapshotProper
 	return nil
 }
 
+func (b *snapshotsBuilder) getSnapshotSize(props *internal_windows.VssSnapshotProperties) *SnapshotSize {
+	if props.ProviderID != *internal_windows.SystemProviderID {
+		return nil
+	}
+
+	volumeName := ole.UTF16PtrToString(props.OriginalVolumeName)
+
+	cowUsage, ok := b.cowUsageByVolume[volumeName]
+	if !ok {
+		cowUsage = getCowUsage(volumeName)
+		b.cowUsageByVolume[volumeName] = cowUsage
+	}
+
+	// VSS only knows the size of the diff area, that is shared by all snapshots of the volume
+	return &SnapshotSize{
+		Exclusive: -1,
+		Shared:    -1,
+		CowUsage:  cowUsage,
+	}
+}
+
 func (s *windowsSnapshoter) listSnapshotsAndSets(filterSnapshotID string, filterSetID string) ([]*Snapshot, []*SnapshotSet, error) {
 	bc, err := s.NewBackupComponentsForManagement()
 	defer bc.Close()
@@ -394,6 +419,54 @@ func (s *windowsSnapshoter) ListMountPoints(volume string) ([]string, error) {
 	return append(result, volume+`\`), nil
 }
 
+func (s *windowsSnapshoter) GetVolumeUsage(volume string) (*VolumeUsage, error) {
+	result, err := newVolumeUsage(volume)
+	if err != nil {
+		return nil, err
+	}
+
+	areas, err := internal_windows.QueryDiffAreasForVolume(addPathSeparatorAsSuffix(volume))
+	if err != nil {
+		s.infoCallback(DetailsLevel, "Error querying VSS diff areas of %v: %v", volume, err)
+		return result, nil
+	}
+
+	result.SnapshotsSize = 0
+	result.SnapshotsMaxSize = 0
+
+	for _, a := range areas {
+		result.SnapshotsSize += a.UsedSpace
+
+		if a.MaximumSpace < 0 || result.SnapshotsMaxSize < 0 {
+			result.SnapshotsMaxSize = -1
+		} else {
+			result.SnapshotsMaxSize += a.MaximumSpace
+		}
+	}
+
+	return result, nil
+}
+
+// getCowUsage returns the percentage used of the diff areas of a volume, or -1 if unknown or unlimited.
+func getCowUsage(volume string) float64 {
+	areas, err := internal_windows.QueryDiffAreasForVolume(volume)
+	if err != nil || len(areas) == 0 {
+		return -1
+	}
+
+	var used, max int64
+	for _, a := range areas {
+		if a.MaximumSpace <= 0 {
+			return -1
+		}
+
+		used += a.UsedSpace
+		max += a.MaximumSpace
+	}
+
+	return float64(used) * 100 / float64(max)
+}
+
 func (s *windowsSnapshoter) StartBackup(cfg *BackupConfig) (Backuper, error) {
 	if cfg == nil {
 		cfg = &BackupConfig{}
@@ -409,7 +482,48 @@ func (s *windowsSnapshoter) StartBackup(cfg *BackupConfig) (Backuper, error) {
 		ic = s.infoCallback
 	}
 
-	return newWindowsBackuper(s, providerID, cfg.Timeout, cfg.Simple, ic), nil
+	cleanupTemporarySnapshots(s, ic)
+
+	result := newWindowsBackuper(s, providerID, cfg.Timeout, cfg.Simple, ic)
+	result.setPolicies(cfg)
+	result.freeSpace = newFreeSpaceGuard(cfg, s, ic)
+	result.monitor = newSnapshotMonitor(cfg, s, &result.baseBackuper, result.extendCowSpace, ic)
+
+	return result, nil
+}
+
+func (s *windowsSnapshoter) CleanupTemporarySnapshots() (int, error) {
+	return cleanupOrphanedJournals(s.undoJournalEntry, s.infoCallback)
+}
+
+func (s *windowsSnapshoter) undoJournalEntry(e *journalEntry) error {
+	if e.Type != journalVssSet {
+		return errors.Errorf("unknown journal entry type: %v", e.Type)
+	}
+
+	if e.Data == "" {
+		// Snapshot set was never started
+		return nil
+	}
+
+	// Only full IDs, because DeleteSet also accepts simplified ones that could match other sets
+	guid := ole.NewGUID(e.Data)
+	if guid == nil || toGuidString(*guid) != strings.ToLower(e.Data) {
+		return errors.Errorf("invalid snapshot set ID: %v", e.Data)
+	}
+
+	sets, err := s.ListSets(e.Data)
+	if err != nil {
+		return err
+	}
+	if len(sets) == 0 {
+		return nil
+	}
+
+	s.infoCallback(DetailsLevel, "Deleting snapshot set %v", e.Data)
+
+	_, err = s.DeleteSet(e.Data, true)
+	return err
 }
 
 func (s *windowsSnapshoter) getProviderID(id string) (*ole.GUID, error) {
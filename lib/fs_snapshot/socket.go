@@ -0,0 +1,115 @@
+package fs_snapshot
+
+import (
+	"context"
+	"net"
+	"os"
+	"os/user"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/credentials"
+)
+
+// DefaultSocket is the unix socket used by default if it exists. Not used in Windows.
+const DefaultSocket = "/var/run/fs_snapshot.sock"
+
+const unixPrefix = "unix:"
+const tcpPrefix = "tcp:"
+
+// listen creates a listener for an endpoint in the format "unix:/path/to/socket", "tcp:ip:port" or "ip:port".
+func listen(endpoint string) (net.Listener, error) {
+	if !strings.HasPrefix(endpoint, unixPrefix) {
+		return net.Listen("tcp", strings.TrimPrefix(endpoint, tcpPrefix))
+	}
+
+	if !unixSocketsSupported() {
+		return nil, errors.Errorf("unix sockets not supported in this OS: %v", endpoint)
+	}
+
+	path := strings.TrimPrefix(endpoint, unixPrefix)
+
+	// Remove a socket left behind by a server that died
+	err := os.Remove(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	lis, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Anyone can connect, and the users are authorized using the peer credentials
+	err = os.Chmod(path, 0o666)
+	if err != nil {
+		_ = lis.Close()
+		return nil, err
+	}
+
+	return lis, nil
+}
+
+// peerCredentialsAuthInfo has the information of the process on the other side of a unix socket.
+type peerCredentialsAuthInfo struct {
+	credentials.CommonAuthInfo
+	UID int
+	GID int
+}
+
+func (i peerCredentialsAuthInfo) AuthType() string {
+	return "peercred"
+}
+
+// Username returns the name of the user on the other side of the socket.
+func (i peerCredentialsAuthInfo) Username() (string, error) {
+	u, err := user.LookupId(strconv.Itoa(i.UID))
+	if err != nil {
+		return "", err
+	}
+
+	return u.Username, nil
+}
+
+// serverTransportCredentials reads the peer credentials of unix sockets and uses the tcp credentials
+// for the other connections.
+type serverTransportCredentials struct {
+	tcp credentials.TransportCredentials
+}
+
+func (c *serverTransportCredentials) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	uc, ok := conn.(*net.UnixConn)
+	if !ok {
+		return c.tcp.ServerHandshake(conn)
+	}
+
+	uid, gid, err := getPeerCredentials(uc)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "error reading peer credentials")
+	}
+
+	return conn, peerCredentialsAuthInfo{
+		CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.PrivacyAndIntegrity},
+		UID:            uid,
+		GID:            gid,
+	}, nil
+}
+
+func (c *serverTransportCredentials) ClientHandshake(ctx context.Context, authority string, conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return c.tcp.ClientHandshake(ctx, authority, conn)
+}
+
+func (c *serverTransportCredentials) Info() credentials.ProtocolInfo {
+	return c.tcp.Info()
+}
+
+func (c *serverTransportCredentials) Clone() credentials.TransportCredentials {
+	return &serverTransportCredentials{
+		tcp: c.tcp.Clone(),
+	}
+}
+
+func (c *serverTransportCredentials) OverrideServerName(name string) error {
+	return c.tcp.OverrideServerName(name)
+}
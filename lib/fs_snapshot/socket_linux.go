@@ -0,0 +1,35 @@
+//go:build linux
+
+package fs_snapshot
+
+import (
+	"net"
+
+	"golang.org/x/sys/unix"
+)
+
+func unixSocketsSupported() bool {
+	return true
+}
+
+func getPeerCredentials(conn *net.UnixConn) (int, int, error) {
+	raw, err := conn.SyscallConn()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var cred *unix.Ucred
+	var credErr error
+
+	err = raw.Control(func(fd uintptr) {
+		cred, credErr = unix.GetsockoptUcred(int(fd), unix.SOL_SOCKET, unix.SO_PEERCRED)
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	if credErr != nil {
+		return 0, 0, credErr
+	}
+
+	return int(cred.Uid), int(cred.Gid), nil
+}
@@ -0,0 +1,40 @@
+//go:build darwin
+
+package fs_snapshot
+
+import (
+	"net"
+
+	"golang.org/x/sys/unix"
+)
+
+func unixSocketsSupported() bool {
+	return true
+}
+
+func getPeerCredentials(conn *net.UnixConn) (int, int, error) {
+	raw, err := conn.SyscallConn()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var cred *unix.Xucred
+	var credErr error
+
+	err = raw.Control(func(fd uintptr) {
+		cred, credErr = unix.GetsockoptXucred(int(fd), unix.SOL_LOCAL, unix.LOCAL_PEERCRED)
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	if credErr != nil {
+		return 0, 0, credErr
+	}
+
+	gid := -1
+	if cred.Ngroups > 0 {
+		gid = int(cred.Groups[0])
+	}
+
+	return int(cred.Uid), gid, nil
+}
@@ -0,0 +1,15 @@
+//go:build !linux && !darwin
+
+package fs_snapshot
+
+import (
+	"net"
+)
+
+func unixSocketsSupported() bool {
+	return false
+}
+
+func getPeerCredentials(conn *net.UnixConn) (int, int, error) {
+	return 0, 0, ErrNotSupportedInThisOS
+}
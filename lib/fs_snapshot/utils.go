@@ -4,7 +4,9 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"runtime"
 	"strings"
+	"unicode"
 )
 
 // absolutePath is only needed on windows, but because of the server we need it to always be there.
@@ -30,6 +32,69 @@ func addPathSeparatorAsSuffix(dir string) string {
 	return dir
 }
 
+// normalizeDevicePath makes Windows device paths comparable, because \\.\ and \\?\ are the same for them.
+func normalizeDevicePath(path string) string {
+	if runtime.GOOS == "windows" && strings.HasPrefix(path, `\\.\`) {
+		return `\\?\` + path[4:]
+	}
+
+	return path
+}
+
+// detectCaseSensitivity checks if the file system of a dir is case-sensitive, looking for an entry whose name
+// changes with the case and checking if it can be found with the other case. Returns def if it can't be detected.
+func detectCaseSensitivity(dir string, def bool) bool {
+	f, err := os.Open(dir)
+	if err != nil {
+		return def
+	}
+	defer f.Close()
+
+	// Don't read huge dirs
+	for i := 0; i < 16; i++ {
+		names, err := f.Readdirnames(64)
+
+		for _, name := range names {
+			swapped := swapCase(name)
+			if swapped == name {
+				continue
+			}
+
+			original, err := os.Lstat(filepath.Join(dir, name))
+			if err != nil {
+				continue
+			}
+
+			other, err := os.Lstat(filepath.Join(dir, swapped))
+			if os.IsNotExist(err) {
+				return true
+			}
+			if err != nil {
+				continue
+			}
+
+			// Both names exist: they are the same entry only if the case is ignored
+			return !os.SameFile(original, other)
+		}
+
+		if err != nil {
+			return def
+		}
+	}
+
+	return def
+}
+
+func swapCase(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsUpper(r) {
+			return unicode.ToLower(r)
+		}
+
+		return unicode.ToUpper(r)
+	}, s)
+}
+
 func changeBaseDir(path string, oldBase string, newBase string) (string, error) {
 	relative, err := filepath.Rel(oldBase, path)
 	if err != nil {
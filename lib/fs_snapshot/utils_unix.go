@@ -0,0 +1,51 @@
+//go:build !windows
+
+package fs_snapshot
+
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+func processExists(pid int) bool {
+	p, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+
+	err = p.Signal(syscall.Signal(0))
+
+	// EPERM means the process exists, but is owned by another user
+	return err == nil || err == syscall.EPERM
+}
+
+// getDiskSpace returns the total size and the space available to the current user of the volume of a dir.
+func getDiskSpace(dir string) (int64, int64, error) {
+	var st unix.Statfs_t
+
+	err := unix.Statfs(dir, &st)
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "error getting disk space of %v", dir)
+	}
+
+	return int64(st.Blocks) * int64(st.Bsize), int64(st.Bavail) * int64(st.Bsize), nil
+}
+
+func isTransientErrorForOS(err error) bool {
+	return false
+}
+
+// realPath returns the absolute path with all symbolic links resolved. Bind mounts are not resolved, but they
+// are returned by ListMountPoints, so the path is matched to them.
+func realPath(path string) (string, error) {
+	abs, err := absolutePath(path)
+	if err != nil {
+		return path, err
+	}
+
+	return filepath.EvalSymlinks(abs)
+}
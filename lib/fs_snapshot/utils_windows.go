@@ -8,6 +8,10 @@ import (
 	"time"
 
 	"github.com/go-ole/go-ole"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/windows"
+
+	"github.com/pescuma/go-fs-snapshot/lib/fs_snapshot/internal/windows"
 )
 
 func toGuidString(id ole.GUID) string {
@@ -34,3 +38,86 @@ func toDate(filetime uint64) time.Time {
 func createScheduledTaskName(username string) string {
 	return fmt.Sprintf(`\fs_snapshot\server start (%v)`, strings.ReplaceAll(username, `\`, `_`))
 }
+
+func processExists(pid int) bool {
+	h, err := windows.OpenProcess(windows.PROCESS_QUERY_LIMITED_INFORMATION, false, uint32(pid))
+	if err == windows.ERROR_ACCESS_DENIED {
+		return true
+	}
+	if err != nil {
+		return false
+	}
+	defer windows.CloseHandle(h)
+
+	var code uint32
+	err = windows.GetExitCodeProcess(h, &code)
+	if err != nil {
+		return false
+	}
+
+	const stillActive = 259
+
+	return code == stillActive
+}
+
+// getDiskSpace returns the total size and the space available to the current user of the volume of a dir.
+func getDiskSpace(dir string) (int64, int64, error) {
+	dirPointer, err := windows.UTF16PtrFromString(dir)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var available, total, free uint64
+
+	err = windows.GetDiskFreeSpaceEx(dirPointer, &available, &total, &free)
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "error getting disk space of %v", dir)
+	}
+
+	return int64(total), int64(available), nil
+}
+
+func isTransientErrorForOS(err error) bool {
+	return internal_windows.IsTransientError(err)
+}
+
+// realPath returns the absolute path with all symbolic links and junctions resolved. It asks Windows for the
+// final path because filepath.EvalSymlinks does not resolve junctions.
+func realPath(path string) (string, error) {
+	abs, err := absolutePath(path)
+	if err != nil {
+		return path, err
+	}
+
+	pathPointer, err := windows.UTF16PtrFromString(abs)
+	if err != nil {
+		return path, err
+	}
+
+	// FILE_FLAG_BACKUP_SEMANTICS is needed to open dirs
+	h, err := windows.CreateFile(pathPointer, 0,
+		windows.FILE_SHARE_READ|windows.FILE_SHARE_WRITE|windows.FILE_SHARE_DELETE, nil,
+		windows.OPEN_EXISTING, windows.FILE_FLAG_BACKUP_SEMANTICS, 0)
+	if err != nil {
+		return path, errors.Wrapf(err, "error opening %v", abs)
+	}
+	defer windows.CloseHandle(h)
+
+	const fileNameNormalized = 0x0
+	const volumeNameDos = 0x0
+
+	buf := make([]uint16, windows.MAX_LONG_PATH)
+	n, err := windows.GetFinalPathNameByHandle(h, &buf[0], uint32(len(buf)), fileNameNormalized|volumeNameDos)
+	if err != nil {
+		return path, errors.Wrapf(err, "error resolving %v", abs)
+	}
+
+	final := windows.UTF16ToString(buf[:n])
+
+	// The result always starts with \\?\
+	if strings.HasPrefix(final, `\\?\UNC\`) {
+		return `\\` + final[8:], nil
+	}
+
+	return absolutePath(final)
+}
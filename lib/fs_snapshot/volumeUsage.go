@@ -0,0 +1,90 @@
+package fs_snapshot
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// SnapshotSize is the space used by a snapshot. The values are -1 when the provider can't compute them.
+type SnapshotSize struct {
+	// Exclusive is the space used only by this snapshot, that is freed when it is deleted
+	Exclusive int64
+	// Shared is the space this snapshot shares with the original volume or with other snapshots
+	Shared int64
+	// CowUsage is the percentage used of the space reserved for copy-on-write data. In VSS this space is
+	// shared by all the snapshots of the volume.
+	CowUsage float64
+}
+
+// VolumeUsage has the space of a volume, and how much of it is used by snapshots.
+type VolumeUsage struct {
+	Volume    string
+	TotalSize int64
+	FreeSpace int64
+	// SnapshotsSize is the space used by all the snapshots of the volume, or -1 if unknown
+	SnapshotsSize int64
+	// SnapshotsMaxSize is the maximum space the snapshots can use, or -1 if unlimited or unknown
+	SnapshotsMaxSize int64
+}
+
+// newVolumeUsage returns the usage of a volume without the snapshots information.
+func newVolumeUsage(volume string) (*VolumeUsage, error) {
+	total, free, err := getDiskSpace(volume)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VolumeUsage{
+		Volume:           volume,
+		TotalSize:        total,
+		FreeSpace:        free,
+		SnapshotsSize:    -1,
+		SnapshotsMaxSize: -1,
+	}, nil
+}
+
+// FormatSize returns a size in bytes in a human readable format, or - if it is unknown (negative).
+func FormatSize(size int64) string {
+	if size < 0 {
+		return "-"
+	}
+
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%v B", size)
+	}
+
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
+
+// ParseSize parses a size in bytes, with an optional unit: K, M, G or T (multiples of 1024, so 1G is the same
+// as 1GiB), like 500M or 1.5G.
+func ParseSize(size string) (int64, error) {
+	s := strings.ToUpper(strings.TrimSpace(size))
+	s = strings.TrimSuffix(s, "B")
+	s = strings.TrimSuffix(s, "I")
+
+	multiplier := int64(1)
+	if s != "" {
+		if i := strings.IndexByte("KMGT", s[len(s)-1]); i >= 0 {
+			multiplier = int64(1) << (10 * (i + 1))
+			s = strings.TrimSpace(s[:len(s)-1])
+		}
+	}
+
+	value, err := strconv.ParseFloat(s, 64)
+	if err != nil || value < 0 {
+		return 0, errors.Errorf("invalid size: %v", size)
+	}
+
+	return int64(value * float64(multiplier)), nil
+}
@@ -0,0 +1,65 @@
+//go:build linux || darwin
+
+package fs_snapshot
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+// readXattrs returns the extended attributes of a file, without following symbolic links.
+func readXattrs(path string) (map[string][]byte, error) {
+	size, err := unix.Llistxattr(path, nil)
+	if err == unix.ENOTSUP || err == unix.EPERM {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing extended attributes of %v", path)
+	}
+	if size == 0 {
+		return nil, nil
+	}
+
+	buffer := make([]byte, size)
+	size, err = unix.Llistxattr(path, buffer)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing extended attributes of %v", path)
+	}
+
+	result := make(map[string][]byte)
+
+	for _, name := range bytes.Split(buffer[:size], []byte{0}) {
+		if len(name) == 0 {
+			continue
+		}
+
+		value, err := readXattr(path, string(name))
+		if err == unix.ENODATA {
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reading extended attribute %v of %v", string(name), path)
+		}
+
+		result[string(name)] = value
+	}
+
+	return result, nil
+}
+
+func readXattr(path string, name string) ([]byte, error) {
+	size, err := unix.Lgetxattr(path, name, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	value := make([]byte, size)
+	size, err = unix.Lgetxattr(path, name, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return value[:size], nil
+}